@@ -4,4 +4,5 @@ type Cell interface {
 	String() string
 	Length() int
 	Original() string
+	Value() interface{}
 }
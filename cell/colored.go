@@ -0,0 +1,26 @@
+package cell
+
+import "github.com/liushuochen/gotable/color"
+
+// Colored struct wraps a cell to print it in color. The length, original value and typed value are the same as the
+// wrapped cell.
+type Colored struct {
+	Cell
+	color *color.Color
+}
+
+// CreateColored creates a colored cell of c. If c is a colored cell, the color of c is replaced.
+func CreateColored(c Cell, col *color.Color) *Colored {
+	if colored, ok := c.(*Colored); ok {
+		c = colored.Cell
+	}
+	return &Colored{Cell: c, color: col}
+}
+
+func (c *Colored) String() string {
+	return c.color.Combine(c.Cell.Original())
+}
+
+func (c *Colored) Color() *color.Color {
+	return c.color
+}
@@ -11,27 +11,47 @@ const (
 	AlignRight
 )
 
+// Wrap modes of a column which has a max width
+const (
+	WrapWord = iota
+	WrapHard
+	WrapTruncate
+)
+
 type Column struct {
 	name         string
-	coloredName  string
+	color        *color.Color
 	defaultValue string
 	align        int
+	kind         int
+	maxWidth     int
+	wrapMode     int
+	priority     int
 	length       int
 }
 
 func CreateColumn(name string) *Column {
 	h := &Column{
 		name:         name,
-		coloredName:  name,
 		defaultValue: "",
 		align:        AlignCenter,
+		kind:         TypeString,
 		length:       util.Length(name),
 	}
 	return h
 }
 
+// Copy returns a new column with the same name, default value, alignment, type and color.
+func (h *Column) Copy() *Column {
+	column := *h
+	return &column
+}
+
 func (h *Column) String() string {
-	return h.coloredName
+	if h.color == nil {
+		return h.name
+	}
+	return h.color.Combine(h.name)
 }
 
 func (h *Column) Original() string {
@@ -78,12 +98,81 @@ func (h *Column) SetAlign(mode int) {
 	}
 }
 
+func (h *Column) MaxWidth() int {
+	return h.maxWidth
+}
+
+func (h *Column) WrapMode() int {
+	return h.wrapMode
+}
+
+// SetMaxWidth sets the max display width of the column and how to handle a longer line, one of WrapWord, WrapHard
+// and WrapTruncate. If width is not greater than 0, the width of the column is not limited.
+func (h *Column) SetMaxWidth(width int, mode int) {
+	if width < 0 {
+		width = 0
+	}
+	h.maxWidth = width
+	h.wrapMode = mode
+}
+
+// Wrap splits value into display lines by line breaks, a line longer than width is handled by the wrap mode of the
+// column. If width is not greater than 0, lines are not wrapped.
+func (h *Column) Wrap(value string, width int) []string {
+	lines := make([]string, 0)
+	for _, line := range util.Lines(value) {
+		switch {
+		case width <= 0:
+			lines = append(lines, line)
+		case h.wrapMode == WrapTruncate:
+			lines = append(lines, util.Truncate(line, width))
+		case h.wrapMode == WrapHard:
+			lines = append(lines, util.HardWrap(line, width)...)
+		default:
+			lines = append(lines, util.WordWrap(line, width)...)
+		}
+	}
+	return lines
+}
+
+func (h *Column) Priority() int {
+	return h.priority
+}
+
+// SetPriority sets the priority of the column, a column with lower priority is hidden first when the table does not
+// fit the width. The default priority is 0.
+func (h *Column) SetPriority(priority int) {
+	h.priority = priority
+}
+
+func (h *Column) Type() int {
+	return h.kind
+}
+
+func (h *Column) TypeString() string {
+	return TypeName(h.kind)
+}
+
+func (h *Column) SetType(kind int) {
+	h.kind = kind
+}
+
+// CreateCell creates a cell from value according to the column type.
+func (h *Column) CreateCell(value string) (Cell, error) {
+	return CreateTypedData(value, h.kind)
+}
+
+func (h *Column) Value() interface{} {
+	return h.Original()
+}
+
 func (h *Column) Equal(other *Column) bool {
 	functions := []func(o *Column) bool{
 		h.nameEqual,
 		h.lengthEqual,
 		h.defaultEqual,
 		h.alignEqual,
+		h.typeEqual,
 	}
 
 	for _, function := range functions {
@@ -99,12 +188,18 @@ func (h *Column) SetColor(displayType, font, background int) {
 	c.Display = displayType
 	c.Font = font
 	c.Background = background
-	h.coloredName = c.Combine(h.Original())
+	h.color = c
 	return
 }
 
+// Color returns the color of the column, it returns nil if the column is not colored.
+func (h *Column) Color() *color.Color {
+	return h.color
+}
+
+// Colorful returns true if the column is colored, whether or not the color is written by the color profile.
 func (h *Column) Colorful() bool {
-	return h.String() != h.Original()
+	return h.color != nil
 }
 
 func (h *Column) nameEqual(other *Column) bool {
@@ -122,3 +217,7 @@ func (h *Column) defaultEqual(other *Column) bool {
 func (h *Column) alignEqual(other *Column) bool {
 	return h.Align() == other.Align()
 }
+
+func (h *Column) typeEqual(other *Column) bool {
+	return h.Type() == other.Type()
+}
@@ -31,3 +31,7 @@ func (d *Data) Length() int {
 func (d *Data) Original() string {
 	return d.String()
 }
+
+func (d *Data) Value() interface{} {
+	return d.value
+}
@@ -0,0 +1,165 @@
+package cell
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liushuochen/gotable/util"
+)
+
+// Column types
+const (
+	TypeString = iota
+	TypeInt
+	TypeFloat
+	TypeBool
+	TypeTime
+)
+
+// NullValue is used to put a null value into a cell of any column type.
+const NullValue = "__NULL__"
+
+// TimeLayouts are the layouts tried in order when parsing a value of TypeTime column.
+var TimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// CreateTypedData creates a cell which type is kind from value. An empty value creates a *Null cell unless kind is
+// TypeString, NullValue always creates a *Null cell. It returns an error if value can not be parsed as kind.
+func CreateTypedData(value string, kind int) (Cell, error) {
+	if value == NullValue || (value == "" && kind != TypeString) {
+		return CreateNull(), nil
+	}
+
+	switch kind {
+	case TypeInt:
+		return CreateInt(value)
+	case TypeFloat:
+		return CreateFloat(value)
+	case TypeBool:
+		return CreateBool(value)
+	case TypeTime:
+		return CreateTime(value)
+	default:
+		return CreateData(value), nil
+	}
+}
+
+// TypeName returns the name of a column type.
+func TypeName(kind int) string {
+	switch kind {
+	case TypeString:
+		return "string"
+	case TypeInt:
+		return "int"
+	case TypeFloat:
+		return "float"
+	case TypeBool:
+		return "bool"
+	case TypeTime:
+		return "time"
+	default:
+		return "unknown"
+	}
+}
+
+type Int struct {
+	*Data
+	number int64
+}
+
+func CreateInt(value string) (*Int, error) {
+	number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &Int{Data: CreateData(value), number: number}, nil
+}
+
+func (i *Int) Value() interface{} {
+	return i.number
+}
+
+type Float struct {
+	*Data
+	number float64
+}
+
+func CreateFloat(value string) (*Float, error) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return nil, strconv.ErrSyntax
+	}
+	return &Float{Data: CreateData(value), number: number}, nil
+}
+
+func (f *Float) Value() interface{} {
+	return f.number
+}
+
+type Bool struct {
+	*Data
+	boolean bool
+}
+
+func CreateBool(value string) (*Bool, error) {
+	boolean, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return nil, err
+	}
+	return &Bool{Data: CreateData(value), boolean: boolean}, nil
+}
+
+func (b *Bool) Value() interface{} {
+	return b.boolean
+}
+
+type Time struct {
+	*Data
+	time time.Time
+}
+
+func CreateTime(value string) (*Time, error) {
+	var err error
+	for _, layout := range TimeLayouts {
+		var t time.Time
+		t, err = time.Parse(layout, strings.TrimSpace(value))
+		if err == nil {
+			return &Time{Data: CreateData(value), time: t}, nil
+		}
+	}
+	return nil, err
+}
+
+func (t *Time) Value() interface{} {
+	return t.time
+}
+
+type Null struct{}
+
+func CreateNull() *Null {
+	return new(Null)
+}
+
+func (n *Null) String() string {
+	return ""
+}
+
+func (n *Null) Length() int {
+	return util.Length(n.String())
+}
+
+func (n *Null) Original() string {
+	return n.String()
+}
+
+func (n *Null) Value() interface{} {
+	return nil
+}
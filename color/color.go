@@ -1,6 +1,17 @@
 package color
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Flags of extended color values. A font or background value with one of these flags is a 256-color palette index
+// or a 24-bit RGB color instead of an SGR code, use Color256 and RGB functions to create them.
+const (
+	flag256 = 1 << 30
+	flagRGB = 1 << 29
+)
 
 type Color struct {
 	Display    int
@@ -8,13 +19,124 @@ type Color struct {
 	Background int
 }
 
-// Combine into a terminal escape sequence
+// Color256 returns a font or background value of the 256-color palette index.
+func Color256(index uint8) int {
+	return flag256 | int(index)
+}
+
+// RGB returns a font or background value of a 24-bit true color.
+func RGB(r, g, b uint8) int {
+	return flagRGB | int(r)<<16 | int(g)<<8 | int(b)
+}
+
+// IsExtended reports whether value is created by Color256 or RGB.
+func IsExtended(value int) bool {
+	return value&(flag256|flagRGB) != 0
+}
+
+// Combine into a terminal escape sequence. The colors are downgraded to the color profile of the terminal, see
+// GetProfile. If the profile is NoColor, message is returned unchanged.
 func (c *Color) Combine(message string) string {
-	value := ""
-	if c.Background == 0 {
-		value = fmt.Sprintf("\033[%d;%dm%s\033[0m", c.Display, c.Font, message)
-	} else {
-		value = fmt.Sprintf("\033[%d;%d;%dm%s\033[0m", c.Display, c.Font, c.Background, message)
+	profile := GetProfile()
+	if profile == NoColor {
+		return message
+	}
+
+	codes := []string{strconv.Itoa(c.Display), sgr(c.Font, false, profile)}
+	if c.Background != 0 {
+		codes = append(codes, sgr(c.Background, true, profile))
+	}
+	return fmt.Sprintf("\033[%sm%s\033[0m", strings.Join(codes, ";"), message)
+}
+
+// sgr function returns the SGR parameters of a font or background value in profile.
+func sgr(value int, background bool, profile Profile) string {
+	if !IsExtended(value) {
+		return strconv.Itoa(value)
+	}
+
+	offset := 38
+	if background {
+		offset = 48
+	}
+
+	if value&flagRGB != 0 && profile == TrueColor {
+		r, g, b := rgb(value)
+		return fmt.Sprintf("%d;2;%d;%d;%d", offset, r, g, b)
+	}
+
+	index := value & 0xFF
+	if value&flagRGB != 0 {
+		index = rgbTo256(rgb(value))
 	}
-	return value
+	if profile >= ANSI256 {
+		return fmt.Sprintf("%d;5;%d", offset, index)
+	}
+
+	basic := nearestBasic(paletteRGB(index))
+	code := 30 + basic
+	if basic >= 8 {
+		code = 90 + basic - 8
+	}
+	if background {
+		code += 10
+	}
+	return strconv.Itoa(code)
+}
+
+var cssColors = map[int]string{
+	0: "black",
+	1: "red",
+	2: "green",
+	3: "yellow",
+	4: "blue",
+	5: "purple",
+	6: "cyan",
+	7: "white",
+}
+
+// CSS returns the inline css declarations of the color, e.g. "font-weight:bold;color:red".
+func (c *Color) CSS() string {
+	declarations := make([]string, 0)
+	switch c.Display {
+	case 1:
+		declarations = append(declarations, "font-weight:bold")
+	case 2:
+		declarations = append(declarations, "opacity:0.6")
+	case 3:
+		declarations = append(declarations, "font-style:italic")
+	case 4:
+		declarations = append(declarations, "text-decoration:underline")
+	case 5:
+		declarations = append(declarations, "text-decoration:blink")
+	case 9:
+		declarations = append(declarations, "text-decoration:line-through")
+	}
+
+	if value, ok := cssColor(c.Font, 30); ok {
+		declarations = append(declarations, "color:"+value)
+	}
+	if value, ok := cssColor(c.Background, 40); ok {
+		declarations = append(declarations, "background-color:"+value)
+	}
+	return strings.Join(declarations, ";")
+}
+
+// cssColor function returns the css value of a font(base 30) or background(base 40) value.
+func cssColor(value, base int) (string, bool) {
+	switch {
+	case value&flagRGB != 0:
+		return hex(rgb(value)), true
+	case value&flag256 != 0:
+		return hex(paletteRGB(value & 0xFF)), true
+	case value >= base+60 && value <= base+67:
+		return hex(paletteRGB(value - base - 52)), true
+	}
+
+	name, ok := cssColors[value-base]
+	return name, ok
+}
+
+func hex(r, g, b int) string {
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
 }
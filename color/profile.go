@@ -0,0 +1,138 @@
+package color
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// Profile type indicates the colors supported by a terminal.
+type Profile int
+
+// Color profiles
+const (
+	NoColor Profile = iota
+	Basic
+	ANSI256
+	TrueColor
+)
+
+var (
+	profile     Profile
+	profileOnce sync.Once
+	profileLock sync.RWMutex
+)
+
+// DetectProfile returns the color profile of the terminal from environment variables:
+// - NO_COLOR is set to a non-empty value or TERM is "dumb": NoColor.
+// - COLORTERM is "truecolor" or "24bit": TrueColor.
+// - TERM contains "256color": ANSI256.
+// - Otherwise: Basic.
+func DetectProfile() Profile {
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColor
+	}
+
+	term := os.Getenv("TERM")
+	if term == "dumb" {
+		return NoColor
+	}
+
+	colorTerm := strings.ToLower(os.Getenv("COLORTERM"))
+	if colorTerm == "truecolor" || colorTerm == "24bit" {
+		return TrueColor
+	}
+
+	if strings.Contains(term, "256color") {
+		return ANSI256
+	}
+	return Basic
+}
+
+// GetProfile returns the color profile used by Combine. It is detected by DetectProfile at the first call unless it
+// is set by SetProfile.
+func GetProfile() Profile {
+	profileOnce.Do(func() {
+		profileLock.Lock()
+		profile = DetectProfile()
+		profileLock.Unlock()
+	})
+
+	profileLock.RLock()
+	defer profileLock.RUnlock()
+	return profile
+}
+
+// SetProfile used to set the color profile used by Combine instead of the detected one.
+func SetProfile(p Profile) {
+	profileOnce.Do(func() {})
+	profileLock.Lock()
+	profile = p
+	profileLock.Unlock()
+}
+
+// basicRGB contains the RGB values of the 16 basic colors(xterm).
+var basicRGB = [16][3]int{
+	{0x00, 0x00, 0x00}, {0xcd, 0x00, 0x00}, {0x00, 0xcd, 0x00}, {0xcd, 0xcd, 0x00},
+	{0x00, 0x00, 0xee}, {0xcd, 0x00, 0xcd}, {0x00, 0xcd, 0xcd}, {0xe5, 0xe5, 0xe5},
+	{0x7f, 0x7f, 0x7f}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x5c, 0x5c, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+var cubeLevels = [6]int{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+func rgb(value int) (int, int, int) {
+	return value >> 16 & 0xFF, value >> 8 & 0xFF, value & 0xFF
+}
+
+// paletteRGB function returns the RGB value of a 256-color palette index.
+func paletteRGB(index int) (int, int, int) {
+	switch {
+	case index < 16:
+		return basicRGB[index][0], basicRGB[index][1], basicRGB[index][2]
+	case index < 232:
+		index -= 16
+		return cubeLevels[index/36], cubeLevels[index/6%6], cubeLevels[index%6]
+	default:
+		level := 8 + (index-232)*10
+		return level, level, level
+	}
+}
+
+// rgbTo256 function returns the nearest 256-color palette index of a RGB value.
+func rgbTo256(r, g, b int) int {
+	if r == g && g == b {
+		switch {
+		case r < 8:
+			return 16
+		case r > 238:
+			return 231
+		default:
+			return 232 + (r-8)/10
+		}
+	}
+	return 16 + 36*cubeIndex(r) + 6*cubeIndex(g) + cubeIndex(b)
+}
+
+func cubeIndex(value int) int {
+	if value < 48 {
+		return 0
+	}
+	if value < 115 {
+		return 1
+	}
+	return (value - 35) / 40
+}
+
+// nearestBasic function returns the index of the nearest basic color of a RGB value.
+func nearestBasic(r, g, b int) int {
+	nearest, distance := 0, -1
+	for index, c := range basicRGB {
+		dr, dg, db := r-c[0], g-c[1], b-c[2]
+		d := dr*dr + dg*dg + db*db
+		if distance == -1 || d < distance {
+			nearest, distance = index, d
+		}
+	}
+	return nearest
+}
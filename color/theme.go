@@ -0,0 +1,73 @@
+package color
+
+import "sync"
+
+// Theme struct is a set of colors used to print a table. A nil color is not applied.
+// - Name: Name of the theme.
+// - Header: Color of the column names which are not colored by SetColumnColor.
+// - Border: Color of the border.
+// - Row: Color of the odd rows(the first row is odd).
+// - AlternateRow: Color of the even rows.
+type Theme struct {
+	Name         string
+	Header       *Color
+	Border       *Color
+	Row          *Color
+	AlternateRow *Color
+}
+
+var (
+	// ThemeOcean prints blue borders and cyan column names.
+	ThemeOcean = Theme{
+		Name:   "ocean",
+		Header: &Color{Display: 1, Font: 36},
+		Border: &Color{Display: 0, Font: 34},
+	}
+
+	// ThemeForest prints green borders and yellow column names.
+	ThemeForest = Theme{
+		Name:   "forest",
+		Header: &Color{Display: 1, Font: 33},
+		Border: &Color{Display: 0, Font: 32},
+	}
+
+	// ThemeZebra prints the rows with alternating gray backgrounds.
+	ThemeZebra = Theme{
+		Name:         "zebra",
+		Header:       &Color{Display: 1, Font: 37},
+		AlternateRow: &Color{Display: 0, Font: 37, Background: RGB(0x3a, 0x3a, 0x3a)},
+	}
+
+	// ThemeMono prints bold column names and dim borders.
+	ThemeMono = Theme{
+		Name:   "mono",
+		Header: &Color{Display: 1, Font: 39},
+		Border: &Color{Display: 2, Font: 39},
+	}
+)
+
+var (
+	themes = map[string]Theme{
+		ThemeOcean.Name:  ThemeOcean,
+		ThemeForest.Name: ThemeForest,
+		ThemeZebra.Name:  ThemeZebra,
+		ThemeMono.Name:   ThemeMono,
+	}
+	themeLock sync.RWMutex
+)
+
+// LookupTheme returns the theme named name, the second value reports whether the theme exists.
+func LookupTheme(name string) (Theme, bool) {
+	themeLock.RLock()
+	defer themeLock.RUnlock()
+	theme, ok := themes[name]
+	return theme, ok
+}
+
+// RegisterTheme used to add a theme which can be found by LookupTheme. It is safe to call concurrently with
+// LookupTheme.
+func RegisterTheme(theme Theme) {
+	themeLock.Lock()
+	themes[theme.Name] = theme
+	themeLock.Unlock()
+}
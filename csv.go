@@ -0,0 +1,129 @@
+package gotable
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/table"
+	"io"
+	"os"
+)
+
+// CSVOptions struct controls how ReadCSV parses the csv input.
+//   - Delimiter: Field delimiter, e.g. '\t' for TSV or ';'. The default is ','.
+//   - Comment: Lines beginning with the comment character are ignored. The default is 0(no comment).
+//   - NoHeader: The first line is a row instead of the column names. The columns are named by Columns, or column1,
+//     column2... if Columns is empty.
+//   - Columns: Column names of headerless input.
+//   - LazyQuotes: A quote may appear in an unquoted field and a non-doubled quote may appear in a quoted field.
+//   - Strict: Every line must have the same number of fields as the header, otherwise an error is returned. By default,
+//     missing fields are set to the default value of the column and extra fields are ignored.
+type CSVOptions struct {
+	Delimiter  rune
+	Comment    rune
+	NoHeader   bool
+	Columns    []string
+	LazyQuotes bool
+	Strict     bool
+}
+
+// utf8BOM is the byte order mark that may begin a UTF-8 csv file.
+const utf8BOM = "\xEF\xBB\xBF"
+
+// ReadCSV reads a csv input from r to create a *table instance. The input is read line by line, so that it is not
+// fully buffered, and a leading UTF-8 byte order mark is stripped.
+// Error:
+//   - If the input has no column, an *exception.ColumnsLengthError is returned.
+//   - If a line can not be parsed, or has a wrong number of fields in strict mode, an *exception.CSVFormatError which
+//     contains the line number is returned.
+//   - If there are duplicate columns, an error is returned.
+func ReadCSV(r io.Reader, options CSVOptions) (*table.Table, error) {
+	buffer := bufio.NewReader(r)
+	if bom, err := buffer.Peek(len(utf8BOM)); err == nil && string(bom) == utf8BOM {
+		_, _ = buffer.Discard(len(utf8BOM))
+	}
+
+	reader := csv.NewReader(buffer)
+	if options.Delimiter != 0 {
+		reader.Comma = options.Delimiter
+	}
+	reader.Comment = options.Comment
+	reader.LazyQuotes = options.LazyQuotes
+	reader.FieldsPerRecord = -1
+	if options.Strict {
+		reader.FieldsPerRecord = 0
+		if options.NoHeader && len(options.Columns) > 0 {
+			reader.FieldsPerRecord = len(options.Columns)
+		}
+	}
+	reader.ReuseRecord = true
+
+	record, err := readCSVRecord(reader)
+	if err == io.EOF {
+		if options.NoHeader && len(options.Columns) > 0 {
+			return Create(options.Columns...)
+		}
+		return nil, exception.ColumnsLength()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := options.Columns
+	if !options.NoHeader {
+		columns = append([]string(nil), record...)
+	} else if len(columns) == 0 {
+		for i := range record {
+			columns = append(columns, fmt.Sprintf("column%d", i+1))
+		}
+	}
+
+	tb, err := Create(columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.NoHeader {
+		record, err = readCSVRecord(reader)
+	}
+	for err == nil {
+		row := make([]string, 0, len(columns))
+		for i := range columns {
+			if i < len(record) {
+				row = append(row, record[i])
+			} else {
+				row = append(row, Default)
+			}
+		}
+		err = tb.AddRow(row)
+		if err != nil {
+			return nil, err
+		}
+		record, err = readCSVRecord(reader)
+	}
+	if err != io.EOF {
+		return nil, err
+	}
+	return tb, nil
+}
+
+// readCSVRecord function reads a record from reader, a parse error is converted to an *exception.CSVFormatError.
+func readCSVRecord(reader *csv.Reader) ([]string, error) {
+	record, err := reader.Read()
+	if parseError, ok := err.(*csv.ParseError); ok {
+		return nil, exception.CSVFormat(parseError.Line, parseError.Err)
+	}
+	return record, err
+}
+
+// Read from a csv file to create a *table instance.
+// This function is a private function that only called from Read function. It will return a table pointer and an error.
+// Error:
+// - If the contents of the csv file are empty, an *exception.ColumnsLengthError is returned.
+// - If there are duplicate columns in the parse result, an error is returned.
+// - If a line can not be parsed, an *exception.CSVFormatError is returned.
+// - Otherwise the value if error is nil.
+func readFromCSVFile(file *os.File) (*table.Table, error) {
+	return ReadCSV(file, CSVOptions{})
+}
@@ -0,0 +1,76 @@
+package exception
+
+import "fmt"
+
+type ValueTypeError struct {
+	*baseError
+	column string
+	value  string
+	t      string
+}
+
+func ValueType(column, value, t string) *ValueTypeError {
+	message := fmt.Sprintf("value %q of column %s is not a valid %s", value, column, t)
+	err := &ValueTypeError{
+		baseError: createBaseError(message),
+		column:    column,
+		value:     value,
+		t:         t,
+	}
+	return err
+}
+
+func (e *ValueTypeError) Column() string {
+	return e.column
+}
+
+func (e *ValueTypeError) Value() string {
+	return e.value
+}
+
+func (e *ValueTypeError) Type() string {
+	return e.t
+}
+
+type ScanFieldError struct {
+	*baseError
+	row    int
+	column string
+	field  string
+	value  string
+	err    error
+}
+
+func ScanField(row int, column, field, value string, err error) *ScanFieldError {
+	message := fmt.Sprintf("row %d: can not scan value %q of column %s into field %s: %s", row, value, column, field,
+		err)
+	return &ScanFieldError{
+		baseError: createBaseError(message),
+		row:       row,
+		column:    column,
+		field:     field,
+		value:     value,
+		err:       err,
+	}
+}
+
+func (e *ScanFieldError) Row() int {
+	return e.row
+}
+
+func (e *ScanFieldError) Column() string {
+	return e.column
+}
+
+func (e *ScanFieldError) Field() string {
+	return e.field
+}
+
+func (e *ScanFieldError) Value() string {
+	return e.value
+}
+
+// Unwrap returns the conversion error.
+func (e *ScanFieldError) Unwrap() error {
+	return e.err
+}
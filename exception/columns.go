@@ -25,3 +25,33 @@ func ColumnDoNotExist(name string) *ColumnDoNotExistError {
 	err := &ColumnDoNotExistError{createBaseError(message), name}
 	return err
 }
+
+type InvalidHeaderGroupError struct {
+	*baseError
+	label string
+}
+
+func (e *InvalidHeaderGroupError) Label() string {
+	return e.label
+}
+
+func InvalidHeaderGroup(label string) *InvalidHeaderGroupError {
+	message := fmt.Sprintf("header group %s must contain contiguous columns which are not in another group", label)
+	err := &InvalidHeaderGroupError{createBaseError(message), label}
+	return err
+}
+
+type UnsupportedColumnTypeError struct {
+	*baseError
+	kind int
+}
+
+func (e *UnsupportedColumnTypeError) Kind() int {
+	return e.kind
+}
+
+func UnsupportedColumnType(kind int) *UnsupportedColumnTypeError {
+	message := fmt.Sprintf("unsupported column type %d", kind)
+	err := &UnsupportedColumnTypeError{createBaseError(message), kind}
+	return err
+}
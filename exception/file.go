@@ -46,6 +46,26 @@ func NotARegularJSONFile(path string) *NotARegularJSONFileError {
 	return err
 }
 
+type NotARegularMarkdownFileError struct {
+	*fileError
+}
+
+func NotARegularMarkdownFile(path string) *NotARegularMarkdownFileError {
+	message := fmt.Sprintf("not a regular markdown file: %s", path)
+	err := &NotARegularMarkdownFileError{fileError: createFileError(path, message)}
+	return err
+}
+
+type NotARegularHTMLFileError struct {
+	*fileError
+}
+
+func NotARegularHTMLFile(path string) *NotARegularHTMLFileError {
+	message := fmt.Sprintf("not a regular html file: %s", path)
+	err := &NotARegularHTMLFileError{fileError: createFileError(path, message)}
+	return err
+}
+
 type UnSupportedFileTypeError struct {
 	*fileError
 }
@@ -36,3 +36,86 @@ func RowLengthNotEqualColumns(rowLength, columnLength int) *RowLengthNotEqualCol
 	}
 	return err
 }
+
+type RowIndexOutOfRangeError struct {
+	*baseError
+	index  int
+	length int
+}
+
+func RowIndexOutOfRange(index, length int) *RowIndexOutOfRangeError {
+	message := fmt.Sprintf("row index %d out of range [0:%d]", index, length)
+	err := &RowIndexOutOfRangeError{
+		baseError: createBaseError(message),
+		index:     index,
+		length:    length,
+	}
+	return err
+}
+
+func (e *RowIndexOutOfRangeError) Index() int {
+	return e.index
+}
+
+func (e *RowIndexOutOfRangeError) Length() int {
+	return e.length
+}
+
+type PageOutOfRangeError struct {
+	*baseError
+	page  int
+	pages int
+}
+
+func PageOutOfRange(page, pages int) *PageOutOfRangeError {
+	message := fmt.Sprintf("page %d out of range [1:%d]", page, pages)
+	err := &PageOutOfRangeError{
+		baseError: createBaseError(message),
+		page:      page,
+		pages:     pages,
+	}
+	return err
+}
+
+func (e *PageOutOfRangeError) Page() int {
+	return e.page
+}
+
+func (e *PageOutOfRangeError) Pages() int {
+	return e.pages
+}
+
+type CSVFormatError struct {
+	*baseError
+	line int
+	err  error
+}
+
+func CSVFormat(line int, err error) *CSVFormatError {
+	message := fmt.Sprintf("csv line %d: %s", line, err)
+	return &CSVFormatError{
+		baseError: createBaseError(message),
+		line:      line,
+		err:       err,
+	}
+}
+
+func (e *CSVFormatError) Line() int {
+	return e.line
+}
+
+// Unwrap returns the parse error of the line.
+func (e *CSVFormatError) Unwrap() error {
+	return e.err
+}
+
+type StreamClosedError struct {
+	*baseError
+}
+
+func StreamClosed() *StreamClosedError {
+	err := &StreamClosedError{
+		baseError: createBaseError("can not add a row to a closed stream"),
+	}
+	return err
+}
@@ -1,14 +1,11 @@
 package gotable
 
 import (
-	"encoding/csv"
-	"encoding/json"
+	"github.com/liushuochen/gotable/color"
 	"github.com/liushuochen/gotable/exception"
 	"github.com/liushuochen/gotable/table"
 	"github.com/liushuochen/gotable/util"
-	"io/ioutil"
 	"os"
-	"reflect"
 	"strings"
 )
 
@@ -17,14 +14,27 @@ const (
 	Left    = table.L
 	Right   = table.R
 	Default = table.Default
+	Null    = table.Null
+)
+
+// Column types, used in conjunction with the SetColumnType method.
+const (
+	StringType = table.TypeString
+	IntType    = table.TypeInt
+	FloatType  = table.TypeFloat
+	BoolType   = table.TypeBool
+	TimeType   = table.TypeTime
 )
 
 // Colored display control
 const (
 	TerminalDefault = 0
 	Highlight       = 1
+	Dim             = 2
+	Italic          = 3
 	Underline       = 4
 	Flash           = 5
+	Strikethrough   = 9
 )
 
 // Colored control
@@ -40,6 +50,55 @@ const (
 	NoneBackground = 0
 )
 
+// Bright colored control
+const (
+	BrightBlack  = 90
+	BrightRed    = 91
+	BrightGreen  = 92
+	BrightYellow = 93
+	BrightBlue   = 94
+	BrightPurple = 95
+	BrightCyan   = 96
+	BrightWhite  = 97
+)
+
+// Color256 returns a font or background color of the 256-color palette index, it can be used as the font or
+// background argument of the SetColumnColor method.
+func Color256(index uint8) int {
+	return color.Color256(index)
+}
+
+// RGB returns a 24-bit true color, it can be used as the font or background argument of the SetColumnColor method.
+// The color is downgraded to the 256-color palette or the basic colors if the terminal does not support it.
+func RGB(r, g, b uint8) int {
+	return color.RGB(r, g, b)
+}
+
+// Border styles, used in conjunction with the SetBorderStyle method.
+var (
+	ASCIIBorder    = table.StyleASCII
+	LightBorder    = table.StyleLight
+	HeavyBorder    = table.StyleHeavy
+	DoubleBorder   = table.StyleDouble
+	RoundedBorder  = table.StyleRounded
+	MarkdownBorder = table.StyleMarkdown
+	NoneBorder     = table.StyleNone
+)
+
+// Wrap modes, used in conjunction with the SetColumnMaxWidth method.
+const (
+	WrapWord     = table.WrapWord
+	WrapHard     = table.WrapHard
+	WrapTruncate = table.WrapTruncate
+)
+
+// Sort modes, used in conjunction with the table.SortKey struct.
+const (
+	SortByType  = table.SortByType
+	SortNatural = table.SortNatural
+	SortNumeric = table.SortNumeric
+)
+
 // Create an empty simple table. When duplicate values in columns, table creation fails.
 // It will return a table pointer and an error.
 // Error:
@@ -71,30 +130,19 @@ func CreateSafeTable(columns ...string) (*table.SafeTable, error) {
 }
 
 // CreateByStruct creates an empty table from struct. You can rename a field using struct tag: gotable
+// The tag supports options, e.g. `gotable:"name,omitempty,default=N/A,align=right"`, embedded and nested structs are
+// flattened. Rows are added from structs by AddRow and AddStructs.
+// Breaking changes since 5.18.0: unexported fields are no longer columns, a field tagged with "-" is ignored instead of
+// being named "-", embedded and nested structs are flattened, and the tag text after the first "," holds options.
 // It will return a table pointer and an error.
 // Error:
 // - If the length of columns is not greater than 0, an *exception.ColumnsLengthError error is returned.
 // - If columns contain duplicate values, an error is returned.
 // - Otherwise, the value of error is nil.
 func CreateByStruct(v interface{}) (*table.Table, error) {
-	set := &table.Set{}
-	s := reflect.TypeOf(v).Elem()
-	numField := s.NumField()
-	if numField <= 0 {
-		return nil, exception.ColumnsLength()
-	}
-
-	for i := 0; i < numField; i++ {
-		field := s.Field(i)
-		name := field.Tag.Get("gotable")
-		if name == "" {
-			name = field.Name
-		}
-
-		err := set.Add(name)
-		if err != nil {
-			return nil, err
-		}
+	set, err := table.CreateSetFromStruct(v)
+	if err != nil {
+		return nil, err
 	}
 	tb := table.CreateTable(set)
 	return tb, nil
@@ -117,68 +165,20 @@ func getVersions() []string {
 	return []string{"5", "18", "0"}
 }
 
-// Read from a csv file to create a *table instance.
-// This function is a private function that only called from Read function. It will return a table pointer and an error.
-// Error:
-// - If the contents of the csv file are empty, an *exception.ColumnsLengthError is returned.
-// - If there are duplicate columns in the parse result, an error is returned.
-// - Otherwise the value if error is nil.
-func readFromCSVFile(file *os.File) (*table.Table, error) {
-	reader := csv.NewReader(file)
-	lines, err := reader.ReadAll()
-	if err != nil {
-		return nil, err
-	}
-	if len(lines) < 1 {
-		return Create()
-	}
-
-	tb, err := Create(lines[0]...)
-	if err != nil {
-		return nil, err
-	}
-
-	rows := make([]map[string]string, 0)
-	for _, line := range lines[1:] {
-		row := make(map[string]string)
-		for i := range line {
-			row[lines[0][i]] = line[i]
-		}
-		rows = append(rows, row)
-	}
-	tb.AddRows(rows)
-	return tb, nil
-}
-
 // Read from a json file to create a *table instance.
 // This function is a private function that only called from Read function. It will return a table pointer and an error.
 // Error:
 //   - If the contents of the json file are not eligible table contents, an *exception.NotGotableJSONFormatError is
-//     returned.
+//     returned. The contents are an array of objects, or an object with "columns" and "rows"(see readJSON).
+//   - If the json file has no column, an *exception.ColumnsLengthError is returned.
 //   - If there are duplicate columns in the parse result, an error is returned.
 //   - Otherwise the value if error is nil.
 func readFromJSONFile(file *os.File) (*table.Table, error) {
-	byteValue, err := ioutil.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-
-	rows := make([]map[string]string, 0)
-	err = json.Unmarshal(byteValue, &rows)
-	if err != nil {
+	tb, ok, err := readJSON(file)
+	if !ok {
 		return nil, exception.NotGotableJSONFormat(file.Name())
 	}
-
-	columns := make([]string, 0)
-	for column := range rows[0] {
-		columns = append(columns, column)
-	}
-	tb, err := Create(columns...)
-	if err != nil {
-		return nil, err
-	}
-	tb.AddRows(rows)
-	return tb, nil
+	return tb, err
 }
 
 // Read from file to create a *table instance.
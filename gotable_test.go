@@ -2,9 +2,19 @@
 package gotable_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/color"
 	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/table"
+	"github.com/liushuochen/gotable/util"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/liushuochen/gotable"
 )
@@ -202,3 +212,793 @@ func TestReadCSVFile(t *testing.T) {
 		t.Errorf("expected table length is 3, but %d got.", table.Length())
 	}
 }
+
+// Check simple table and safe table print the same border with a border style.
+func TestBorderStyle(t *testing.T) {
+	expected := "┌───┬───┐\n│ a │ b │\n├───┼───┤\n│ 1 │ 2 │\n└───┴───┘\n"
+
+	table, _ := gotable.Create("a", "b")
+	_ = table.AddRow([]string{"1", "2"})
+	table.SetBorderStyle(gotable.LightBorder)
+	if table.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, table.String())
+	}
+
+	safeTable, _ := gotable.CreateSafeTable("a", "b")
+	_ = safeTable.AddRow([]string{"1", "2"})
+	safeTable.SetBorderStyle(gotable.LightBorder)
+	if safeTable.String() != expected {
+		t.Errorf("expected safe table is\n%s, but\n%s got.", expected, safeTable.String())
+	}
+}
+
+// Check the markdown table with column alignment and escaped values.
+func TestMarkdown(t *testing.T) {
+	table, _ := gotable.Create("name", "value")
+	table.Align("name", gotable.Left)
+	table.Align("value", gotable.Right)
+	_ = table.AddRow([]string{"a|b", "line1\nline2"})
+
+	expected := "| name | value |\n| :--- | ---: |\n| a\\|b | line1<br>line2 |"
+	if table.Markdown() != expected {
+		t.Errorf("expected markdown is\n%s, but\n%s got.", expected, table.Markdown())
+	}
+}
+
+// Check the html table with escaped values and colored column.
+func TestHTML(t *testing.T) {
+	table, _ := gotable.Create("name")
+	table.Align("name", gotable.Left)
+	table.SetColumnColor("name", gotable.Highlight, gotable.Red, gotable.NoneBackground)
+	_ = table.AddRow([]string{"<b>"})
+
+	expected := "<table>\n<thead>\n<tr>\n<th style=\"text-align:left;font-weight:bold;color:red\">name</th>\n</tr>\n" +
+		"</thead>\n<tbody>\n<tr>\n<td style=\"text-align:left\">&lt;b&gt;</td>\n</tr>\n</tbody>\n</table>"
+	if table.HTML(0) != expected {
+		t.Errorf("expected html is\n%s, but\n%s got.", expected, table.HTML(0))
+	}
+}
+
+// Check typed columns are written as JSON numbers, booleans and null.
+func TestTypedColumnJSON(t *testing.T) {
+	table, _ := gotable.Create("id", "ok", "note")
+	_ = table.AddRow([]string{"10", "true", "x"})
+	_ = table.AddRow([]string{"", "false", gotable.Null})
+	if err := table.SetColumnType("id", gotable.IntType); err != nil {
+		t.Errorf("expected err is nil, but %s got.", err.Error())
+	}
+	_ = table.SetColumnType("ok", gotable.BoolType)
+	if _, ok := table.SetColumnType("note", 99).(*exception.UnsupportedColumnTypeError); !ok {
+		t.Errorf("expected an UnsupportedColumnTypeError for an unknown kind.")
+	}
+
+	expected := `[{"id":10,"note":"x","ok":true},{"id":null,"note":null,"ok":false}]`
+	result, _ := table.JSON(0)
+	compacted := new(bytes.Buffer)
+	_ = json.Compact(compacted, []byte(result))
+	if compacted.String() != expected {
+		t.Errorf("expected json is %s, but %s got.", expected, compacted.String())
+	}
+
+	err := table.AddRow([]string{"ten", "true", ""})
+	switch err.(type) {
+	case *exception.ValueTypeError:
+	default:
+		t.Errorf("expected err is ValueTypeError, but %T got", err)
+	}
+}
+
+// Check sort rows by multiple keys.
+func TestSortBy(t *testing.T) {
+	tb, _ := gotable.Create("group", "name")
+	tb.AddRows([]map[string]string{
+		{"group": "b", "name": "item10"},
+		{"group": "a", "name": "item2"},
+		{"group": "b", "name": "item9"},
+		{"group": "a", "name": "item1"},
+	})
+
+	err := tb.SortBy(table.Desc("group"), table.SortKey{Column: "name", Mode: gotable.SortNatural})
+	if err != nil {
+		t.Errorf("expected err is nil, but %s got.", err.Error())
+	}
+
+	expected := []string{"item9", "item10", "item1", "item2"}
+	for index, row := range tb.GetValues() {
+		if row["name"] != expected[index] {
+			t.Errorf("expected name of row %d is %s, but %s got.", index, expected[index], row["name"])
+		}
+	}
+
+	err = tb.SortBy(table.Asc("age"))
+	switch err.(type) {
+	case *exception.ColumnDoNotExistError:
+	default:
+		t.Errorf("expected err is ColumnDoNotExistError, but %T got", err)
+	}
+}
+
+// Check filter returns a new table with matched rows and the same columns.
+func TestFilter(t *testing.T) {
+	tb, _ := gotable.Create("name", "age")
+	tb.Align("name", gotable.Left)
+	tb.AddRows([]map[string]string{
+		{"name": "Alice", "age": "30"},
+		{"name": "Bob", "age": "12"},
+		{"name": "Amy", "age": "8"},
+	})
+
+	result := tb.Filter(table.And(table.Prefix("name", "A"), table.Gt("age", 10)))
+	if result.Length() != 1 || result.GetValues()[0]["name"] != "Alice" {
+		t.Errorf("expected only Alice is matched, but %v got.", result.GetValues())
+	}
+
+	if !result.EqualColumns(tb) {
+		t.Error("expected the columns of filtered table equal to the original table.")
+	}
+
+	if tb.Length() != 3 {
+		t.Errorf("expected original table length is 3, but %d got.", tb.Length())
+	}
+}
+
+// Check drop column, rows and duplicates.
+func TestDrop(t *testing.T) {
+	tb, _ := gotable.CreateSafeTable("name", "age")
+	tb.AddRows([]map[string]string{
+		{"name": "Alice", "age": "30"},
+		{"name": "Bob", "age": "12"},
+		{"name": "Bob", "age": "13"},
+		{"name": "Amy", "age": "8"},
+	})
+
+	if dropped := tb.DropRows(table.Eq("name", "Amy")); dropped != 1 {
+		t.Errorf("expected 1 row dropped, but %d got.", dropped)
+	}
+
+	if err := tb.DropColumn("age"); err != nil {
+		t.Errorf("expected err is nil, but %s got.", err.Error())
+	}
+
+	if dropped, _ := tb.DropDuplicates(); dropped != 1 {
+		t.Errorf("expected 1 duplicate row dropped, but %d got.", dropped)
+	}
+
+	err := tb.DropRow(2)
+	switch err.(type) {
+	case *exception.RowIndexOutOfRangeError:
+	default:
+		t.Errorf("expected err is RowIndexOutOfRangeError, but %T got", err)
+	}
+
+	_ = tb.DropRow(0)
+	expected := "+------+\n| name |\n+------+\n| Bob  |\n+------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+}
+
+// Check read and update cells.
+func TestCell(t *testing.T) {
+	tb, _ := gotable.Create("name", "age")
+	_ = tb.SetColumnType("age", gotable.IntType)
+	tb.AddRows([]map[string]string{
+		{"name": "Alice", "age": "30"},
+		{"name": "Bob", "age": "12"},
+	})
+
+	if err := tb.SetCell(1, "age", "13"); err != nil {
+		t.Errorf("expected err is nil, but %s got.", err.Error())
+	}
+	c, _ := tb.GetCell(1, "age")
+	if c.Value() != int64(13) {
+		t.Errorf("expected age is 13, but %v got.", c.Value())
+	}
+
+	if _, err := tb.UpdateRows(table.Eq("name", "Alice"), map[string]string{"age": "old"}); err == nil {
+		t.Error("expected got an error, but nil got.")
+	}
+
+	updated, _ := tb.UpdateRows(table.Gt("age", 20), map[string]string{"name": "Carol"})
+	row, _ := tb.GetRow(0)
+	if updated != 1 || row["name"] != "Carol" {
+		t.Errorf("expected name of row 0 is Carol, but %s got.", row["name"])
+	}
+
+	_, err := tb.GetCell(0, "grade")
+	switch err.(type) {
+	case *exception.ColumnDoNotExistError:
+	default:
+		t.Errorf("expected err is ColumnDoNotExistError, but %T got", err)
+	}
+}
+
+// Check slice and print table page by page.
+func TestPager(t *testing.T) {
+	tb, _ := gotable.Create("id")
+	for _, id := range []string{"1", "2", "3", "1000"} {
+		_ = tb.AddRow([]string{id})
+	}
+
+	if tb.Head(2).Length() != 2 || tb.Tail(10).Length() != 4 || tb.Slice(3, 1).Length() != 0 {
+		t.Error("expected Head, Tail and Slice are clamped to the table length.")
+	}
+
+	st, _ := gotable.CreateSafeTable("id")
+	_ = st.AddRow([]string{"1"})
+	if tb.Head(-1).Length() != 0 || tb.Slice(0, -3).Length() != 0 || tb.Tail(-1).Length() != 0 ||
+		st.Head(-1).Length() != 0 {
+		t.Error("expected a negative n or end gives an empty table.")
+	}
+
+	pager := tb.Pager(3)
+	pager.StableWidth = true
+	if pager.Pages() != 2 {
+		t.Errorf("expected 2 pages, but %d got.", pager.Pages())
+	}
+
+	page, _ := pager.Page(2)
+	expected := "+------+\n|  id  |\n+------+\n| 1000 |\n+------+\nrows 4–4 of 4\n"
+	if page != expected {
+		t.Errorf("expected page is\n%s, but\n%s got.", expected, page)
+	}
+
+	_, err := pager.Page(3)
+	switch err.(type) {
+	case *exception.PageOutOfRangeError:
+	default:
+		t.Errorf("expected err is PageOutOfRangeError, but %T got", err)
+	}
+}
+
+// Check write table into io.Writer and stream rows.
+func TestWriteTo(t *testing.T) {
+	tb, _ := gotable.Create("id", "name")
+	_ = tb.AddRow([]string{"1", "Alice"})
+
+	buffer := new(bytes.Buffer)
+	n, err := tb.WriteTo(buffer)
+	if err != nil || buffer.String() != tb.String() || n != int64(buffer.Len()) {
+		t.Errorf("expected table is\n%s, but\n%s got.", tb.String(), buffer.String())
+	}
+
+	buffer.Reset()
+	stream := tb.Stream(buffer, 2, 5)
+	_ = stream.AddRow([]string{"2", "Bob"})
+	expected := "+----+-------+\n| id | name  |\n+----+-------+\n| 2  |  Bob  |\n"
+	if buffer.String() != expected {
+		t.Errorf("expected stream is\n%s, but\n%s got.", expected, buffer.String())
+	}
+
+	_ = stream.Close()
+	expected += "+----+-------+\n"
+	if buffer.String() != expected {
+		t.Errorf("expected stream is\n%s, but\n%s got.", expected, buffer.String())
+	}
+
+	err = stream.AddRow([]string{"3", "Tom"})
+	if _, ok := err.(*exception.StreamClosedError); !ok || buffer.String() != expected {
+		t.Errorf("expected a StreamClosedError and no output, but %v and\n%s got.", err, buffer.String())
+	}
+
+	if tb.Length() != 2 {
+		t.Errorf("expected table length is 2, but %d got.", tb.Length())
+	}
+
+	type user struct {
+		ID   string `gotable:"id"`
+		Name string `gotable:"name"`
+	}
+	buffer.Reset()
+	stream = tb.Stream(buffer, 2, 5)
+	_ = stream.AddRow([]user{{"3", "a"}, {"4", "b"}})
+	_ = stream.Close()
+	expected = "+----+-------+\n| id | name  |\n+----+-------+\n| 3  |   a   |\n| 4  |   b   |\n+----+-------+\n"
+	if buffer.String() != expected {
+		t.Errorf("expected stream is\n%s, but\n%s got.", expected, buffer.String())
+	}
+}
+
+// Check the column width of Japanese, Korean, emoji and combining characters.
+func TestUnicodeWidth(t *testing.T) {
+	tb, _ := gotable.Create("name")
+	tb.Align("name", gotable.Left)
+	for _, name := range []string{"こんにちは", "한국", "👨‍👩‍👧", "é"} {
+		_ = tb.AddRow([]string{name})
+	}
+
+	expected := "+------------+\n|name        |\n+------------+\n|こんにちは  |\n|한국        |\n" +
+		"|👨‍👩‍👧          |\n|e\u0301           |\n+------------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+}
+
+// Check colored values and hyperlinks do not widen the column, and colors can be disabled.
+func TestColoredValueWidth(t *testing.T) {
+	tb, _ := gotable.Create("status")
+	tb.SetColumnColor("status", gotable.Highlight, gotable.Red, gotable.NoneBackground)
+	_ = tb.AddRow([]string{"\033[32mOK\033[0m"})
+	_ = tb.AddRow([]string{"\033]8;;https://example.com\033\\link\033]8;;\033\\"})
+
+	tb.DisableColor()
+	expected := "+--------+\n| status |\n+--------+\n|   OK   |\n|  link  |\n+--------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+}
+
+// Check cell color, row color and color rules.
+func TestCellColor(t *testing.T) {
+	color.SetProfile(color.Basic)
+	tb, _ := gotable.Create("name", "status")
+	_ = tb.AddRow([]string{"build", "FAILED"})
+	_ = tb.AddRow([]string{"test", "OK"})
+
+	_ = tb.AddColorRule("status", table.Eq("status", "FAILED"), gotable.Highlight, gotable.Red, gotable.NoneBackground)
+	_ = tb.SetRowColor(1, gotable.TerminalDefault, gotable.Green, gotable.NoneBackground)
+
+	expected := "+-------+--------+\n| name  | status |\n+-------+--------+\n" +
+		"| build | \033[1;31mFAILED\033[0m |\n" +
+		"| \033[0;32mtest\033[0m  |   \033[0;32mOK\033[0m   |\n+-------+--------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%q, but\n%q got.", expected, tb.String())
+	}
+
+	err := tb.SetCellColor(2, "name", gotable.Highlight, gotable.Red, gotable.NoneBackground)
+	switch err.(type) {
+	case *exception.RowIndexOutOfRangeError:
+	default:
+		t.Errorf("expected err is RowIndexOutOfRangeError, but %T got", err)
+	}
+
+	_ = tb.SetCellColor(0, "status", gotable.Highlight, gotable.Red, gotable.NoneBackground)
+	if tb.GetValues()[0]["status"] != "FAILED" || !tb.Exist(map[string]string{"status": "FAILED"}) {
+		t.Errorf("expected the values are not colored, but %v got.", tb.GetValues())
+	}
+
+	dir, _ := ioutil.TempDir("", "gotable")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	path := filepath.Join(dir, "table.csv")
+	_ = tb.ToCSVFile(path)
+	content, _ := ioutil.ReadFile(path)
+	if string(content) != "name,status\nbuild,FAILED\ntest,OK\n" {
+		t.Errorf("expected csv content is not colored, but %q got.", string(content))
+	}
+}
+
+// Check extended colors are downgraded to the color profile.
+func TestExtendedColor(t *testing.T) {
+	c := &color.Color{Display: gotable.Italic, Font: gotable.RGB(255, 0, 0), Background: gotable.Color256(21)}
+
+	expected := map[color.Profile]string{
+		color.TrueColor: "\033[3;38;2;255;0;0;48;5;21mx\033[0m",
+		color.ANSI256:   "\033[3;38;5;196;48;5;21mx\033[0m",
+		color.Basic:     "\033[3;91;44mx\033[0m",
+		color.NoColor:   "x",
+	}
+	for profile, value := range expected {
+		color.SetProfile(profile)
+		if c.Combine("x") != value {
+			t.Errorf("expected %q in profile %d, but %q got.", value, profile, c.Combine("x"))
+		}
+	}
+
+	column := cell.CreateColumn("x")
+	column.SetColor(gotable.Highlight, gotable.Red, gotable.NoneBackground)
+	color.SetProfile(color.NoColor)
+	if !column.Colorful() || cell.CreateColumn("y").Colorful() {
+		t.Errorf("expected only the colored column is colorful in profile %d.", color.NoColor)
+	}
+	color.SetProfile(color.Basic)
+}
+
+// Check an empty NO_COLOR does not disable colors.
+func TestDetectProfile(t *testing.T) {
+	for _, name := range []string{"NO_COLOR", "TERM", "COLORTERM"} {
+		value, ok := os.LookupEnv(name)
+		defer func(name, value string, ok bool) {
+			if ok {
+				_ = os.Setenv(name, value)
+			} else {
+				_ = os.Unsetenv(name)
+			}
+		}(name, value, ok)
+	}
+	_ = os.Setenv("TERM", "xterm")
+	_ = os.Unsetenv("COLORTERM")
+
+	_ = os.Setenv("NO_COLOR", "")
+	if color.DetectProfile() != color.Basic {
+		t.Errorf("expected an empty NO_COLOR does not disable colors, but %d got.", color.DetectProfile())
+	}
+	_ = os.Setenv("NO_COLOR", "1")
+	if color.DetectProfile() != color.NoColor {
+		t.Errorf("expected NO_COLOR disables colors, but %d got.", color.DetectProfile())
+	}
+}
+
+// Check multi-line cells and column max width.
+func TestMultiLineCell(t *testing.T) {
+	tb, _ := gotable.CreateSafeTable("id", "note")
+	tb.SetColumnMaxWidth("note", 9, gotable.WrapWord)
+	_ = tb.AddRow([]string{"1", "a\nb"})
+	_ = tb.AddRow([]string{"2", "hello big world"})
+
+	expected := "+----+-----------+\n| id |   note    |\n+----+-----------+\n" +
+		"| 1  |     a     |\n|    |     b     |\n| 2  | hello big |\n|    |   world   |\n+----+-----------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	tb.SetColumnMaxWidth("note", 9, gotable.WrapTruncate)
+	if !strings.Contains(tb.String(), "| hello bi… |") {
+		t.Errorf("expected the value is truncated, but\n%s got.", tb.String())
+	}
+}
+
+// Check the table is fitted into the fit width.
+func TestFitWidth(t *testing.T) {
+	tb, _ := gotable.Create("id", "name", "description")
+	_ = tb.AddRow([]string{"1", "bob", "a long description"})
+
+	tb.SetFitWidth(20, false)
+	for _, line := range strings.Split(strings.TrimSuffix(tb.String(), "\n"), "\n") {
+		if util.Length(line) > 20 {
+			t.Errorf("expected the width of line is not greater than 20, but %q got.", line)
+		}
+	}
+
+	tb.SetColumnPriority("id", 1)
+	tb.SetFitWidth(12, true)
+	expected := "+----+-----+\n| id | nam |\n|    |  e  |\n+----+-----+\n| 1  | bob |\n+----+-----+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+}
+
+// Check the footer row of column aggregations.
+func TestFooter(t *testing.T) {
+	tb, _ := gotable.Create("name", "score")
+	_ = tb.SetColumnType("score", gotable.IntType)
+	_ = tb.AddRow([]string{"bob", "3"})
+	_ = tb.AddRow([]string{"tom", "5"})
+	_ = tb.AddRow([]string{"amy", gotable.Null})
+	_ = tb.SetFooter("name", table.Label("total"))
+	_ = tb.SetFooter("score", table.Sum)
+
+	expected := "+-------+-------+\n| name  | score |\n+-------+-------+\n|  bob  |   3   |\n|  tom  |   5   |\n" +
+		"|  amy  |       |\n+-------+-------+\n| total |   8   |\n+-------+-------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	_ = tb.SetFooter("score", table.Avg)
+	tb.ExportFooter(true)
+	content, _ := tb.JSON(0)
+	var buffer bytes.Buffer
+	_ = json.Compact(&buffer, []byte(content))
+	if !strings.HasSuffix(buffer.String(), `{"name":"total","score":4}]`) {
+		t.Errorf("expected the footer is exported, but %s got.", buffer.String())
+	}
+
+	if err := tb.SetFooter("unknown", table.Count); err == nil {
+		t.Errorf("expected an error for a column that does not exist.")
+	}
+}
+
+// Check the title and caption of table.
+func TestTitle(t *testing.T) {
+	tb, _ := gotable.Create("id", "name")
+	_ = tb.AddRow([]string{"1", "bob"})
+	tb.SetTitle("Users")
+	tb.SetCaption("1 user")
+
+	expected := "    Users\n+----+------+\n| id | name |\n+----+------+\n| 1  | bob  |\n+----+------+\n   1 user\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	if !strings.HasPrefix(tb.Markdown(), "**Users**\n\n") || !strings.HasSuffix(tb.Markdown(), "\n\n_1 user_") {
+		t.Errorf("expected the title and caption in markdown, but\n%s got.", tb.Markdown())
+	}
+
+	if !strings.Contains(tb.HTML(0), "<caption>Users</caption>") {
+		t.Errorf("expected the caption element in html, but\n%s got.", tb.HTML(0))
+	}
+
+	if !strings.Contains(tb.GoString(), "Title:Users") {
+		t.Errorf("expected the title in GoString, but %s got.", tb.GoString())
+	}
+}
+
+// Check row separators and groups.
+func TestGroupBy(t *testing.T) {
+	tb, _ := gotable.Create("team", "name")
+	_ = tb.AddRow([]string{"a", "bob"})
+	_ = tb.AddRow([]string{"a", "tom"})
+	_ = tb.AddRow([]string{"b", "amy"})
+
+	tb.SetRowSeparator(true)
+	expected := "+------+------+\n| team | name |\n+------+------+\n|  a   | bob  |\n+------+------+\n" +
+		"|  a   | tom  |\n+------+------+\n|  b   | amy  |\n+------+------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	tb.SetRowSeparator(false)
+	_ = tb.GroupBy("team", true)
+	expected = "+------+------+\n| team | name |\n+------+------+\n| team: a     |\n+------+------+\n|      | bob  |\n" +
+		"|      | tom  |\n+------+------+\n| team: b     |\n+------+------+\n|      | amy  |\n+------+------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	_ = tb.DropColumn("team")
+	_ = tb.AddColumn("team")
+	expected = "+------+------+\n| name | team |\n+------+------+\n| bob  |      |\n| tom  |      |\n| amy  |      |\n" +
+		"+------+------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+}
+
+// Check the header groups.
+func TestHeaderGroup(t *testing.T) {
+	tb, _ := gotable.Create("host", "p50", "p99")
+	_ = tb.AddRow([]string{"a", "1", "2"})
+	if err := tb.Columns.AddGroup("latency", "p50", "p99"); err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+
+	expected := "+------+-----+-----+\n|      |  latency  |\n| host | p50 | p99 |\n+------+-----+-----+\n" +
+		"|  a   |  1  |  2  |\n+------+-----+-----+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	if !strings.Contains(tb.HTML(0), "<th colspan=\"2\" style=\"text-align:center\">latency</th>") {
+		t.Errorf("expected colspan in html, but\n%s got.", tb.HTML(0))
+	}
+
+	if err := tb.Columns.AddGroup("other", "host", "p99"); err == nil {
+		t.Errorf("expected an error for columns which are not contiguous.")
+	}
+
+	tb.SetTheme(color.ThemeOcean)
+	tb.DisableColor()
+	if tb.String() != expected {
+		t.Errorf("expected table without color is\n%s, but\n%q got.", expected, tb.String())
+	}
+}
+
+type testLevel int
+
+func (l testLevel) String() string {
+	return []string{"low", "high"}[l]
+}
+
+type testBase struct {
+	ID int `gotable:"id"`
+}
+
+type testUser struct {
+	testBase
+	Name    string    `gotable:"name,default=N/A"`
+	Level   testLevel `gotable:"level,align=right"`
+	Address struct {
+		City string `gotable:"city"`
+	} `gotable:"address"`
+	Note   string `gotable:"note,omitempty"`
+	secret string
+}
+
+// Check adding rows from structs.
+func TestAddRowFromStruct(t *testing.T) {
+	tb, err := gotable.CreateByStruct(&testUser{})
+	if err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+
+	expected := []string{"id", "name", "level", "address.city", "note"}
+	if strings.Join(tb.GetColumns(), ",") != strings.Join(expected, ",") {
+		t.Errorf("expected columns are %v, but %v got.", expected, tb.GetColumns())
+	}
+
+	tb.SetDefault("note", "-")
+	user := testUser{Level: 1}
+	user.ID = 7
+	user.Address.City = "Paris"
+	if err := tb.AddRow(&user); err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if failure, err := tb.AddStructs([]testUser{user, user}); err != nil || len(failure) != 0 {
+		t.Errorf("expected no failure, but %v and %v got.", failure, err)
+	}
+	if _, err := tb.AddStructs([][]string{{"1", "2"}}); err == nil {
+		t.Errorf("expected an error for rows which are not structs.")
+	}
+	if _, ok := tb.AddRow([]int{}).(*exception.UnsupportedRowTypeError); !ok {
+		t.Errorf("expected an UnsupportedRowTypeError for an empty slice which is not structs.")
+	}
+	if _, err := tb.AddStructs([]*testUser{}); err != nil {
+		t.Errorf("unexpected error %s for an empty slice of structs.", err)
+	}
+
+	row, _ := tb.GetRow(0)
+	values := map[string]string{"id": "7", "name": "N/A", "level": "high", "address.city": "Paris", "note": "-"}
+	for column, value := range values {
+		if row[column] != value {
+			t.Errorf("expected %s is %q, but %q got.", column, value, row[column])
+		}
+	}
+	if tb.Length() != 3 {
+		t.Errorf("expected table length is 3, but %d got.", tb.Length())
+	}
+}
+
+type testScanUser struct {
+	ID     int       `gotable:"id"`
+	Name   *string   `gotable:"name"`
+	Score  float64   `gotable:"score"`
+	Active bool      `gotable:"active"`
+	Joined time.Time `gotable:"joined"`
+}
+
+// Check scanning rows into structs.
+func TestScan(t *testing.T) {
+	tb, _ := gotable.Create("id", "name", "score", "active", "joined")
+	_ = tb.AddRow([]string{"1", "bob", "9.5", "true", "2024-01-02"})
+	_ = tb.AddRow([]string{"2", "tom", "", "false", "2024-01-03"})
+
+	var users []testScanUser
+	if err := tb.Scan(&users); err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if len(users) != 2 || users[0].ID != 1 || *users[0].Name != "bob" || users[0].Score != 9.5 ||
+		!users[0].Active || users[0].Joined.Day() != 2 || users[1].Score != 0 {
+		t.Errorf("unexpected scanned rows %+v.", users)
+	}
+
+	_ = tb.AddRow([]string{"x", "amy", "1", "true", "2024-01-04"})
+	err := tb.Scan(&users)
+	if e, ok := err.(*exception.ScanFieldError); !ok || e.Row() != 2 || e.Field() != "ID" {
+		t.Errorf("expected ScanFieldError of row 2 field ID, but %v got.", err)
+	}
+}
+
+type testScanInner struct {
+	Name string `gotable:"name"`
+}
+
+type testScanOuter struct {
+	*testScanInner
+	ID int `gotable:"id"`
+}
+
+// Check scanning into a struct which embeds a pointer to an unexported struct.
+func TestScanUnexportedEmbedded(t *testing.T) {
+	tb, _ := gotable.CreateByStruct(&testScanOuter{})
+	_ = tb.AddRow(testScanOuter{testScanInner: &testScanInner{Name: "bob"}, ID: 1})
+
+	var rows []testScanOuter
+	err := tb.Scan(&rows)
+	if e, ok := err.(*exception.ScanFieldError); !ok || e.Row() != 0 || e.Column() != "name" {
+		t.Errorf("expected ScanFieldError of row 0 column name, but %v got.", err)
+	}
+	if rows != nil {
+		t.Errorf("expected dest is not changed, but %+v got.", rows)
+	}
+}
+
+// Check reading csv input with options.
+func TestReadCSV(t *testing.T) {
+	input := "\xEF\xBB\xBFid;name\n# comment\n1;bob\n2\n"
+	tb, err := gotable.ReadCSV(strings.NewReader(input), gotable.CSVOptions{Delimiter: ';', Comment: '#'})
+	if err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if strings.Join(tb.GetColumns(), ",") != "id,name" || tb.Length() != 2 {
+		t.Errorf("unexpected table\n%s", tb)
+	}
+
+	tb, err = gotable.ReadCSV(strings.NewReader("1\tbob\n"), gotable.CSVOptions{Delimiter: '\t', NoHeader: true})
+	if err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if strings.Join(tb.GetColumns(), ",") != "column1,column2" || tb.Length() != 1 {
+		t.Errorf("unexpected table\n%s", tb)
+	}
+
+	_, err = gotable.ReadCSV(strings.NewReader("id,name\n1,bob\n2\n"), gotable.CSVOptions{Strict: true})
+	if e, ok := err.(*exception.CSVFormatError); !ok || e.Line() != 3 {
+		t.Errorf("expected CSVFormatError of line 3, but %v got.", err)
+	}
+}
+
+// Check reading json files in order.
+func TestReadJSONFile(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "gotable")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	path := filepath.Join(dir, "table.json")
+	content := `[{"name": "bob", "age": 12, "address": {"city": "Paris"}}, {"name": "tom", "active": true, "age": null}]`
+	_ = ioutil.WriteFile(path, []byte(content), 0666)
+
+	tb, err := gotable.Read(path)
+	if err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if strings.Join(tb.GetColumns(), ",") != "name,age,address.city,active" {
+		t.Errorf("unexpected columns %v.", tb.GetColumns())
+	}
+
+	data, _ := tb.JSON(0)
+	var buffer bytes.Buffer
+	_ = json.Compact(&buffer, []byte(data))
+	if !strings.Contains(buffer.String(), `"age":12`) || !strings.Contains(buffer.String(), `"age":null`) {
+		t.Errorf("expected typed values, but %s got.", buffer.String())
+	}
+
+	content = `{"columns": ["id", "name"], "rows": [[1, "bob"], {"name": "tom"}]}`
+	_ = ioutil.WriteFile(path, []byte(content), 0666)
+	tb, err = gotable.Read(path)
+	if err != nil || strings.Join(tb.GetColumns(), ",") != "id,name" || tb.Length() != 2 {
+		t.Errorf("unexpected table %v and error %v.", tb, err)
+	}
+
+	_ = ioutil.WriteFile(path, []byte(`[]`), 0666)
+	if _, err := gotable.Read(path); err == nil {
+		t.Errorf("expected an error for an empty array.")
+	}
+
+	_ = ioutil.WriteFile(path, []byte(`[{"id": 12345678901234567890, "size": 1e400}]`), 0666)
+	tb, err = gotable.Read(path)
+	if err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if row, _ := tb.GetRow(0); row["id"] != "12345678901234567890" || row["size"] != "1e400" {
+		t.Errorf("unexpected row %v.", row)
+	}
+	if content, _ := tb.JSON(0); !strings.Contains(content, "12345678901234567890") {
+		t.Errorf("expected the id is exported unchanged, but %s got.", content)
+	}
+
+	_ = ioutil.WriteFile(path, []byte(`[{"a": "x"}] garbage`), 0666)
+	if _, err := gotable.Read(path); err == nil {
+		t.Errorf("expected an error for trailing data.")
+	}
+}
+
+// Check the generic table of struct rows.
+func TestCreateOf(t *testing.T) {
+	type user struct {
+		ID   int    `gotable:"id"`
+		Name string `gotable:"name"`
+	}
+
+	tb, err := table.CreateOf[user]()
+	if err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+	if err := tb.AddAll([]user{{1, "bob"}, {2, "tom"}}); err != nil {
+		t.Fatalf("unexpected error %s.", err)
+	}
+
+	expected := "+----+------+\n| id | name |\n+----+------+\n| 1  | bob  |\n| 2  | tom  |\n+----+------+\n"
+	if tb.String() != expected {
+		t.Errorf("expected table is\n%s, but\n%s got.", expected, tb.String())
+	}
+
+	rows, err := tb.Rows()
+	if err != nil || len(rows) != 2 || rows[1] != (user{2, "tom"}) {
+		t.Errorf("unexpected rows %v and error %v.", rows, err)
+	}
+
+	if _, err := table.CreateOf[int](); err == nil {
+		t.Errorf("expected an error for a type which is not a struct.")
+	}
+}
@@ -0,0 +1,270 @@
+package gotable
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/liushuochen/gotable/table"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonObject struct is a decoded json object which keeps the order of its keys.
+type jsonObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// decodeJSON function decodes the next json value from decoder. Objects are decoded as *jsonObject, arrays as
+// []interface{} and numbers as json.Number.
+func decodeJSON(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		object := &jsonObject{values: make(map[string]interface{})}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			name := key.(string)
+			if _, exist := object.values[name]; !exist {
+				object.keys = append(object.keys, name)
+			}
+			object.values[name] = value
+		}
+		_, err = decoder.Token()
+		return object, err
+	default:
+		array := make([]interface{}, 0)
+		for decoder.More() {
+			value, err := decodeJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = decoder.Token()
+		return array, err
+	}
+}
+
+// flatten method returns the keys and values of the object in order, nested objects are flattened with dotted keys,
+// e.g. "address.city".
+func (object *jsonObject) flatten(prefix string, keys []string, values map[string]interface{}) []string {
+	for _, key := range object.keys {
+		value := object.values[key]
+		if nested, ok := value.(*jsonObject); ok {
+			keys = nested.flatten(prefix+key+".", keys, values)
+			continue
+		}
+		keys = append(keys, prefix+key)
+		values[prefix+key] = value
+	}
+	return keys
+}
+
+// jsonString function returns the cell value of a decoded json value. A null is converted to gotable.Null, and an
+// array is kept as its json text.
+func jsonString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return Null
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	default:
+		var buffer bytes.Buffer
+		writeJSON(&buffer, v)
+		return buffer.String()
+	}
+}
+
+func writeJSON(buffer *bytes.Buffer, value interface{}) {
+	switch v := value.(type) {
+	case *jsonObject:
+		buffer.WriteString("{")
+		for index, key := range v.keys {
+			if index > 0 {
+				buffer.WriteString(",")
+			}
+			name, _ := json.Marshal(key)
+			buffer.Write(name)
+			buffer.WriteString(":")
+			writeJSON(buffer, v.values[key])
+		}
+		buffer.WriteString("}")
+	case []interface{}:
+		buffer.WriteString("[")
+		for index, element := range v {
+			if index > 0 {
+				buffer.WriteString(",")
+			}
+			writeJSON(buffer, element)
+		}
+		buffer.WriteString("]")
+	default:
+		content, _ := json.Marshal(v)
+		buffer.Write(content)
+	}
+}
+
+// jsonKind function returns the column type of a decoded json value, it returns -1 for a null. An integer out of the
+// range of int64 and a number out of the range of float64 are strings, so that they are exported unchanged.
+func jsonKind(value interface{}) int {
+	switch v := value.(type) {
+	case nil:
+		return -1
+	case bool:
+		return BoolType
+	case json.Number:
+		if _, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return IntType
+		}
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return StringType
+		}
+		if _, err := strconv.ParseFloat(v.String(), 64); err == nil {
+			return FloatType
+		}
+		return StringType
+	default:
+		return StringType
+	}
+}
+
+// mergeKind function returns the column type of values of type x and y. Integers and floats are merged as floats,
+// other different types are merged as strings.
+func mergeKind(x, y int) int {
+	switch {
+	case x == -1 || x == y:
+		return y
+	case y == -1:
+		return x
+	case (x == IntType && y == FloatType) || (x == FloatType && y == IntType):
+		return FloatType
+	default:
+		return StringType
+	}
+}
+
+// readJSON function reads a json document from r to create a *table instance. The document is an array of objects, or
+// an object with "columns"(an array of column names) and "rows"(an array of objects or arrays of values). It reports
+// false if the document is not in these forms or is followed by other data.
+// Columns keep the order of the keys in the document, the keys of all rows are included. Columns whose values are all
+// numbers or bools are typed, nested objects are flattened with dotted column names.
+func readJSON(r io.Reader) (*table.Table, bool, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	document, err := decodeJSON(decoder)
+	if err != nil {
+		return nil, false, nil
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false, nil
+	}
+
+	var columns []string
+	var rows []interface{}
+	switch v := document.(type) {
+	case []interface{}:
+		rows = v
+	case *jsonObject:
+		var ok bool
+		rows, ok = v.values["rows"].([]interface{})
+		names, isArray := v.values["columns"].([]interface{})
+		if !ok || (v.values["columns"] != nil && !isArray) {
+			return nil, false, nil
+		}
+		for _, name := range names {
+			s, ok := name.(string)
+			if !ok {
+				return nil, false, nil
+			}
+			columns = append(columns, s)
+		}
+	default:
+		return nil, false, nil
+	}
+
+	explicit := columns != nil
+	values := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		value := make(map[string]interface{})
+		switch v := row.(type) {
+		case *jsonObject:
+			for _, key := range v.flatten("", nil, value) {
+				if !explicit && !contains(columns, key) {
+					columns = append(columns, key)
+				}
+			}
+		case []interface{}:
+			if !explicit || len(v) > len(columns) {
+				return nil, false, nil
+			}
+			for index, element := range v {
+				value[columns[index]] = element
+			}
+		default:
+			return nil, false, nil
+		}
+		values = append(values, value)
+	}
+
+	tb, err := Create(columns...)
+	if err != nil {
+		return nil, true, err
+	}
+
+	for _, column := range columns {
+		kind := -1
+		for _, value := range values {
+			if v, ok := value[column]; ok {
+				kind = mergeKind(kind, jsonKind(v))
+			}
+		}
+		if kind != -1 && kind != StringType {
+			_ = tb.SetColumnType(column, kind)
+		}
+	}
+
+	for _, value := range values {
+		row := make(map[string]string, len(value))
+		for column, v := range value {
+			row[column] = jsonString(v)
+		}
+		err = tb.AddRow(row)
+		if err != nil {
+			return nil, true, err
+		}
+	}
+	return tb, true, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -4,19 +4,49 @@ package table
 
 import (
 	"fmt"
+	"github.com/liushuochen/gotable/color"
+	"os"
 	"strings"
 )
 
 // base struct contains common attributes to the table.
 // Columns: Table columns
 // border: Control the table border display(true: print table border).
+// style: Border style used to draw the table border. The default is StyleASCII.
+// noColor: Strip colors and other terminal escape sequences when printing the table.
+// colorRules: Rules used to color the cells when printing the table.
+// theme: Colors of the header, border and rows. The default is nil(no theme).
+// rowSeparator: Print a separator line between every two rows.
+// groupBy: Column used to print the rows in groups, an empty string means no group.
+// mergeGroup: Leave the cells of the group column blank in the rows of a group.
+// title: Title printed centered above the table.
+// caption: Caption printed centered under the table.
+// footer: Aggregate of each column printed in the footer row.
+// exportFooter: Write the footer row into the JSON and CSV exports.
+// fitWidth: Max width of the printed table, 0 means no limit.
+// fitTerminal: Use the width of the terminal as the max width of the printed table.
+// hideColumns: Hide the columns with the lowest priority when the table does not fit the max width.
 // tableType: Use to record table types
 // End: Used to set the ending. The default is newline "\n".
 type base struct {
-	Columns   *Set
-	border    bool
-	tableType string
-	End       string
+	Columns      *Set
+	border       bool
+	style        BorderStyle
+	noColor      bool
+	colorRules   []ColorRule
+	theme        *color.Theme
+	rowSeparator bool
+	groupBy      string
+	mergeGroup   bool
+	title        string
+	caption      string
+	footer       map[string]Aggregate
+	exportFooter bool
+	fitWidth     int
+	fitTerminal  bool
+	hideColumns  bool
+	tableType    string
+	End          string
 }
 
 func createTableBase(columns *Set, tableType string, border bool) *base {
@@ -24,10 +54,25 @@ func createTableBase(columns *Set, tableType string, border bool) *base {
 	b.Columns = columns
 	b.tableType = tableType
 	b.border = border
+	b.style = StyleASCII
 	b.End = "\n"
 	return b
 }
 
+// copy method returns a new base with copied columns and the same settings.
+func (b *base) copy() *base {
+	other := *b
+	other.Columns = b.Columns.Copy()
+	other.colorRules = append([]ColorRule(nil), b.colorRules...)
+	if b.footer != nil {
+		other.footer = make(map[string]Aggregate, len(b.footer))
+		for column, aggregate := range b.footer {
+			other.footer[column] = aggregate
+		}
+	}
+	return &other
+}
+
 // Type method returns a table type string.
 func (b *base) Type() string {
 	return b.tableType
@@ -78,10 +123,56 @@ func (b *base) GetDefaults() map[string]string {
 	return defaults
 }
 
-func (b *base) end(content string) string {
-	content = content[:len(content)-1]
-	content += b.End
-	return content
+// SetColumnMaxWidth method used to limit the display width of column. A longer line is handled by mode: WrapWord
+// wraps it at spaces, WrapHard wraps it at any character and WrapTruncate cuts it with an ellipsis. If width is not
+// greater than 0, the width of the column is not limited.
+func (b *base) SetColumnMaxWidth(column string, width int, mode int) {
+	col := b.Columns.Get(column)
+	if col != nil {
+		col.SetMaxWidth(width, mode)
+	}
+}
+
+// CloseBorder method used to hide the table border.
+func (b *base) CloseBorder() {
+	b.border = false
+}
+
+// OpenBorder method used to show the table border.
+func (b *base) OpenBorder() {
+	b.border = true
+}
+
+// DisableColor method used to print the table without colors, the colors of columns and the terminal escape sequences
+// in values are stripped. It is useful when the output is not a terminal, see util.IsTerminal.
+func (b *base) DisableColor() {
+	b.noColor = true
+}
+
+// EnableColor method used to print the colors of the table. By default, colors are enabled.
+func (b *base) EnableColor() {
+	b.noColor = false
+}
+
+// SetBorderStyle method used to change the glyphs used to draw the table border.
+func (b *base) SetBorderStyle(style BorderStyle) {
+	b.style = style
+}
+
+// GetBorderStyle method returns the border style of the table.
+func (b *base) GetBorderStyle() BorderStyle {
+	return b.style
+}
+
+// SetColumnColor method used to set the display type, font color and background color of a column.
+func (b *base) SetColumnColor(columnName string, display, fount, background int) {
+	c := createColor(display, fount, background)
+	for _, col := range b.Columns.base {
+		if col.Original() == columnName {
+			col.SetColor(c.Display, c.Font, c.Background)
+			break
+		}
+	}
 }
 
 // GetColumns method return a list of string that contains all column names.
@@ -103,5 +194,46 @@ func (b *base) header() []string {
 		columns = append(columns, column.Original())
 	}
 	resultList = append(resultList, fmt.Sprintf("Column:[%s]", strings.Join(columns, ",")))
+	if b.title != "" {
+		resultList = append(resultList, fmt.Sprintf("Title:%s", b.title))
+	}
+	if b.caption != "" {
+		resultList = append(resultList, fmt.Sprintf("Caption:%s", b.caption))
+	}
 	return resultList
 }
+
+// writeFile function used to write content into path. The file is created if it does not exist, and truncated if it
+// exists.
+func writeFile(path, content string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) {
+		_ = file.Close()
+	}(file)
+
+	_, err = file.WriteString(content)
+	return err
+}
+
+// SetTitle method used to print title centered above the table. An empty title is not printed.
+func (b *base) SetTitle(title string) {
+	b.title = title
+}
+
+// GetTitle method returns the title of the table.
+func (b *base) GetTitle() string {
+	return b.title
+}
+
+// SetCaption method used to print caption centered under the table. An empty caption is not printed.
+func (b *base) SetCaption(caption string) {
+	b.caption = caption
+}
+
+// GetCaption method returns the caption of the table.
+func (b *base) GetCaption() string {
+	return b.caption
+}
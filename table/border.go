@@ -0,0 +1,100 @@
+// Package table define all table types methods.
+// border.go defines the border styles used to draw the table frame.
+package table
+
+// BorderLine struct contains the glyphs of a horizontal border line. The line is not printed if Fill is empty.
+// - Left: Glyph at the left end of the line.
+// - Fill: Glyph repeated across the width of each column.
+// - Junction: Glyph where the line meets a column separator.
+// - Right: Glyph at the right end of the line.
+type BorderLine struct {
+	Left     string
+	Fill     string
+	Junction string
+	Right    string
+}
+
+func (line BorderLine) visible() bool {
+	return line.Fill != ""
+}
+
+// BorderStyle struct describes how the table border is drawn.
+// - Name: Name of the style.
+// - Top: Line printed above the column names.
+// - Header: Line printed between the column names and the first row.
+// - Separator: Line printed between rows and groups(see SetRowSeparator and GroupBy).
+// - Bottom: Line printed under the last row.
+// - Vertical: Glyph printed between columns and at both edges of a row.
+type BorderStyle struct {
+	Name      string
+	Top       BorderLine
+	Header    BorderLine
+	Separator BorderLine
+	Bottom    BorderLine
+	Vertical  string
+}
+
+var (
+	// StyleASCII is the default border style, drawn with "+", "-" and "|".
+	StyleASCII = BorderStyle{
+		Name:      "ascii",
+		Top:       BorderLine{Left: "+", Fill: "-", Junction: "+", Right: "+"},
+		Header:    BorderLine{Left: "+", Fill: "-", Junction: "+", Right: "+"},
+		Separator: BorderLine{Left: "+", Fill: "-", Junction: "+", Right: "+"},
+		Bottom:    BorderLine{Left: "+", Fill: "-", Junction: "+", Right: "+"},
+		Vertical:  "|",
+	}
+
+	// StyleLight draws the border with Unicode light box-drawing characters.
+	StyleLight = BorderStyle{
+		Name:      "light",
+		Top:       BorderLine{Left: "┌", Fill: "─", Junction: "┬", Right: "┐"},
+		Header:    BorderLine{Left: "├", Fill: "─", Junction: "┼", Right: "┤"},
+		Separator: BorderLine{Left: "├", Fill: "─", Junction: "┼", Right: "┤"},
+		Bottom:    BorderLine{Left: "└", Fill: "─", Junction: "┴", Right: "┘"},
+		Vertical:  "│",
+	}
+
+	// StyleHeavy draws the border with Unicode heavy box-drawing characters.
+	StyleHeavy = BorderStyle{
+		Name:      "heavy",
+		Top:       BorderLine{Left: "┏", Fill: "━", Junction: "┳", Right: "┓"},
+		Header:    BorderLine{Left: "┣", Fill: "━", Junction: "╋", Right: "┫"},
+		Separator: BorderLine{Left: "┣", Fill: "━", Junction: "╋", Right: "┫"},
+		Bottom:    BorderLine{Left: "┗", Fill: "━", Junction: "┻", Right: "┛"},
+		Vertical:  "┃",
+	}
+
+	// StyleDouble draws the border with Unicode double-line box-drawing characters.
+	StyleDouble = BorderStyle{
+		Name:      "double",
+		Top:       BorderLine{Left: "╔", Fill: "═", Junction: "╦", Right: "╗"},
+		Header:    BorderLine{Left: "╠", Fill: "═", Junction: "╬", Right: "╣"},
+		Separator: BorderLine{Left: "╠", Fill: "═", Junction: "╬", Right: "╣"},
+		Bottom:    BorderLine{Left: "╚", Fill: "═", Junction: "╩", Right: "╝"},
+		Vertical:  "║",
+	}
+
+	// StyleRounded is the same as StyleLight but with rounded corners.
+	StyleRounded = BorderStyle{
+		Name:      "rounded",
+		Top:       BorderLine{Left: "╭", Fill: "─", Junction: "┬", Right: "╮"},
+		Header:    BorderLine{Left: "├", Fill: "─", Junction: "┼", Right: "┤"},
+		Separator: BorderLine{Left: "├", Fill: "─", Junction: "┼", Right: "┤"},
+		Bottom:    BorderLine{Left: "╰", Fill: "─", Junction: "┴", Right: "╯"},
+		Vertical:  "│",
+	}
+
+	// StyleMarkdown prints a table that can be pasted into a markdown document.
+	StyleMarkdown = BorderStyle{
+		Name:     "markdown",
+		Header:   BorderLine{Left: "|", Fill: "-", Junction: "|", Right: "|"},
+		Vertical: "|",
+	}
+
+	// StyleNone prints the table without any border line.
+	StyleNone = BorderStyle{
+		Name:     "none",
+		Vertical: " ",
+	}
+)
@@ -0,0 +1,114 @@
+// Package table define all table types methods.
+// cell.go used to read and update the cells of table.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+)
+
+// checkIndex method returns an *exception.RowIndexOutOfRangeError if index is out of range.
+func (tb *Table) checkIndex(index int) error {
+	if index < 0 || index >= tb.Length() {
+		return exception.RowIndexOutOfRange(index, tb.Length())
+	}
+	return nil
+}
+
+// toCells method converts values to cells according to the types of columns. The gotable.Default constant is replaced
+// with the default value of the column.
+func (tb *Table) toCells(values map[string]string) (map[string]cell.Cell, error) {
+	cells := make(map[string]cell.Cell, len(values))
+	for column, value := range values {
+		col := tb.Columns.Get(column)
+		if col == nil {
+			return nil, exception.ColumnDoNotExist(column)
+		}
+
+		if value == Default {
+			value = col.Default()
+		}
+		c, err := col.CreateCell(value)
+		if err != nil {
+			return nil, exception.ValueType(column, value, col.TypeString())
+		}
+		cells[column] = c
+	}
+	return cells, nil
+}
+
+// GetCell method returns the cell at the row index and column.
+// Error:
+// - If row is out of range, an *exception.RowIndexOutOfRangeError is returned.
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (tb *Table) GetCell(row int, column string) (cell.Cell, error) {
+	err := tb.checkIndex(row)
+	if err != nil {
+		return nil, err
+	}
+	if !tb.Columns.Exist(column) {
+		return nil, exception.ColumnDoNotExist(column)
+	}
+	return tb.Row[row][column], nil
+}
+
+// SetCell method used to change the value at the row index and column. The gotable.Default constant can be used as
+// value.
+// Error:
+// - If row is out of range, an *exception.RowIndexOutOfRangeError is returned.
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+// - If value can not be converted to the type of column, an *exception.ValueTypeError is returned.
+func (tb *Table) SetCell(row int, column string, value string) error {
+	err := tb.checkIndex(row)
+	if err != nil {
+		return err
+	}
+
+	cells, err := tb.toCells(map[string]string{column: value})
+	if err != nil {
+		return err
+	}
+	tb.Row[row][column] = cells[column]
+	return nil
+}
+
+// UpdateRows method used to set values to the rows matching predicate, values is a mapping of column and value. It
+// returns the number of updated rows. No row is changed if an error is returned.
+// Error:
+// - If a column does not exist, an *exception.ColumnDoNotExistError is returned.
+// - If a value can not be converted to the type of its column, an *exception.ValueTypeError is returned.
+func (tb *Table) UpdateRows(predicate func(row Row) bool, values map[string]string) (int, error) {
+	cells, err := tb.toCells(values)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, row := range tb.Row {
+		if !predicate(row) {
+			continue
+		}
+
+		for column, c := range cells {
+			row[column] = c
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// GetRow method returns a map that contains the column and value of the row at index.
+// Error:
+// - If index is out of range, an *exception.RowIndexOutOfRangeError is returned.
+func (tb *Table) GetRow(index int) (map[string]string, error) {
+	err := tb.checkIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(map[string]string)
+	for column, value := range tb.Row[index] {
+		row[column] = value.Original()
+	}
+	return row, nil
+}
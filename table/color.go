@@ -0,0 +1,139 @@
+// Package table define all table types methods.
+// color.go used to color the cells and rows of table.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/color"
+	"github.com/liushuochen/gotable/exception"
+)
+
+// ColorRule struct colors the cells of the rows matching a predicate when the table is printed.
+// - Column: Column to be colored. If it is empty, all cells of the row are colored.
+// - Match: Predicate of the row, e.g. table.Eq("status", "FAILED") or table.Gt("latency", 100).
+// - Color: Display type, font color and background color.
+type ColorRule struct {
+	Column string
+	Match  func(row Row) bool
+	Color  *color.Color
+}
+
+// createColor function creates a color from a display type, a font color and a background color. The background is
+// a font color constant(e.g. gotable.Red), an extended color(color.Color256 or color.RGB) or gotable.NoneBackground.
+func createColor(display, font, background int) *color.Color {
+	if background != 0 && !color.IsExtended(background) {
+		background += 10
+	}
+	return &color.Color{Display: display, Font: font, Background: background}
+}
+
+// AddColorRule method used to color the cell of column in the rows matching predicate. If column is an empty string,
+// all cells of the matched rows are colored. Rules are applied when the table is printed, in the order they are
+// added, so a later rule overrides an earlier one.
+// Error:
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (b *base) AddColorRule(column string, predicate func(row Row) bool, display, font, background int) error {
+	if column != "" && !b.Columns.Exist(column) {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	rule := ColorRule{Column: column, Match: predicate, Color: createColor(display, font, background)}
+	b.colorRules = append(b.colorRules, rule)
+	return nil
+}
+
+// SetTheme method used to print the table with the colors of theme, e.g. color.ThemeOcean. The colors set by
+// SetColumnColor, SetCellColor, SetRowColor and color rules take precedence over the theme.
+func (b *base) SetTheme(theme color.Theme) {
+	b.theme = &theme
+}
+
+// DropTheme method used to print the table without theme.
+func (b *base) DropTheme() {
+	b.theme = nil
+}
+
+// ClearColorRules method used to remove all color rules.
+func (b *base) ClearColorRules() {
+	b.colorRules = nil
+}
+
+// applyColorRules method colors cells of row by the color rules, cells are in the order of the columns.
+func (b *base) applyColorRules(row Row, cells []cell.Cell) {
+	for _, rule := range b.colorRules {
+		if !rule.Match(row) {
+			continue
+		}
+
+		for index, column := range b.Columns.base {
+			if rule.Column == "" || rule.Column == column.Original() {
+				cells[index] = cell.CreateColored(cells[index], rule.Color)
+			}
+		}
+	}
+}
+
+// SetCellColor method used to set the color of the cell at the row index and column. The color is kept until the
+// value of the cell is changed, it is only used to print the table and values are read and exported without it.
+// Error:
+// - If row is out of range, an *exception.RowIndexOutOfRangeError is returned.
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (tb *Table) SetCellColor(row int, column string, display, font, background int) error {
+	c, err := tb.GetCell(row, column)
+	if err != nil {
+		return err
+	}
+
+	tb.Row[row][column] = cell.CreateColored(c, createColor(display, font, background))
+	return nil
+}
+
+// SetRowColor method used to set the color of all cells of the row at index.
+// Error:
+// - If index is out of range, an *exception.RowIndexOutOfRangeError is returned.
+func (tb *Table) SetRowColor(index int, display, font, background int) error {
+	err := tb.checkIndex(index)
+	if err != nil {
+		return err
+	}
+
+	c := createColor(display, font, background)
+	for column, value := range tb.Row[index] {
+		tb.Row[index][column] = cell.CreateColored(value, c)
+	}
+	return nil
+}
+
+// SetCellColor method used to set the color of the cell at the row index and column. The color is kept until the
+// value of the cell is changed, it is only used to print the table and values are read and exported without it.
+// Error:
+// - If row is out of range, an *exception.RowIndexOutOfRangeError is returned.
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (st *SafeTable) SetCellColor(row int, column string, display, font, background int) error {
+	if row < 0 || row >= st.Length() {
+		return exception.RowIndexOutOfRange(row, st.Length())
+	}
+	value, ok := st.Row[row].Load(column)
+	if !ok {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	st.Row[row].Store(column, cell.CreateColored(value.(cell.Cell), createColor(display, font, background)))
+	return nil
+}
+
+// SetRowColor method used to set the color of all cells of the row at index.
+// Error:
+// - If index is out of range, an *exception.RowIndexOutOfRangeError is returned.
+func (st *SafeTable) SetRowColor(index int, display, font, background int) error {
+	if index < 0 || index >= st.Length() {
+		return exception.RowIndexOutOfRange(index, st.Length())
+	}
+
+	c := createColor(display, font, background)
+	st.Row[index].Range(func(key, value interface{}) bool {
+		st.Row[index].Store(key, cell.CreateColored(value.(cell.Cell), c))
+		return true
+	})
+	return nil
+}
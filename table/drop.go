@@ -0,0 +1,169 @@
+// Package table define all table types methods.
+// drop.go used to remove columns and rows from table.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"strings"
+)
+
+// checkColumns method returns an *exception.ColumnDoNotExistError if a column does not exist.
+func (b *base) checkColumns(columns []string) error {
+	for _, column := range columns {
+		if !b.Columns.Exist(column) {
+			return exception.ColumnDoNotExist(column)
+		}
+	}
+	return nil
+}
+
+// dropDuplicates function returns rows without the rows that have the same values of columns as a previous row.
+func dropDuplicates(rows []map[string]cell.Cell, columns []string) []map[string]cell.Cell {
+	seen := make(map[string]bool)
+	result := make([]map[string]cell.Cell, 0, len(rows))
+	for _, row := range rows {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			values = append(values, row[column].Original())
+		}
+
+		key := strings.Join(values, "\x00")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, row)
+	}
+	return result
+}
+
+// DropColumn method used to remove a column from the table and all rows.
+// Error:
+// - If the column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (tb *Table) DropColumn(column string) error {
+	if !tb.Columns.Exist(column) {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	_ = tb.Columns.Remove(column)
+	delete(tb.footer, column)
+	if tb.groupBy == column {
+		tb.Ungroup()
+	}
+	for _, row := range tb.Row {
+		delete(row, column)
+	}
+	return nil
+}
+
+// DropRow method used to remove the row at index.
+// Error:
+// - If index is out of range, an *exception.RowIndexOutOfRangeError is returned.
+func (tb *Table) DropRow(index int) error {
+	err := tb.checkIndex(index)
+	if err != nil {
+		return err
+	}
+
+	tb.Row = append(tb.Row[:index], tb.Row[index+1:]...)
+	return nil
+}
+
+// DropRows method used to remove the rows matching predicate. It returns the number of removed rows.
+func (tb *Table) DropRows(predicate func(row Row) bool) int {
+	rows := make([]map[string]cell.Cell, 0, len(tb.Row))
+	for _, row := range tb.Row {
+		if !predicate(row) {
+			rows = append(rows, row)
+		}
+	}
+
+	dropped := len(tb.Row) - len(rows)
+	tb.Row = rows
+	return dropped
+}
+
+// DropDuplicates method used to remove the rows that have the same values of columns as a previous row, the first
+// row is kept. If columns is empty, all columns are compared. It returns the number of removed rows.
+// Error:
+// - If a column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (tb *Table) DropDuplicates(columns ...string) (int, error) {
+	err := tb.checkColumns(columns)
+	if err != nil {
+		return 0, err
+	}
+	if len(columns) == 0 {
+		columns = tb.GetColumns()
+	}
+
+	rows := dropDuplicates(tb.Row, columns)
+	dropped := len(tb.Row) - len(rows)
+	tb.Row = rows
+	return dropped, nil
+}
+
+// DropColumn method used to remove a column from the table and all rows.
+// Error:
+// - If the column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (st *SafeTable) DropColumn(column string) error {
+	if !st.Columns.Exist(column) {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	_ = st.Columns.Remove(column)
+	delete(st.footer, column)
+	if st.groupBy == column {
+		st.Ungroup()
+	}
+	for index := range st.Row {
+		st.Row[index].Delete(column)
+	}
+	return nil
+}
+
+// DropRow method used to remove the row at index.
+// Error:
+// - If index is out of range, an *exception.RowIndexOutOfRangeError is returned.
+func (st *SafeTable) DropRow(index int) error {
+	if index < 0 || index >= st.Length() {
+		return exception.RowIndexOutOfRange(index, st.Length())
+	}
+
+	rows := st.rowMaps()
+	st.setRows(append(rows[:index], rows[index+1:]...))
+	return nil
+}
+
+// DropRows method used to remove the rows matching predicate. It returns the number of removed rows.
+func (st *SafeTable) DropRows(predicate func(row Row) bool) int {
+	rows := make([]map[string]cell.Cell, 0, len(st.Row))
+	for _, row := range st.rowMaps() {
+		if !predicate(row) {
+			rows = append(rows, row)
+		}
+	}
+
+	dropped := len(st.Row) - len(rows)
+	st.setRows(rows)
+	return dropped
+}
+
+// DropDuplicates method used to remove the rows that have the same values of columns as a previous row, the first
+// row is kept. If columns is empty, all columns are compared. It returns the number of removed rows.
+// Error:
+// - If a column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (st *SafeTable) DropDuplicates(columns ...string) (int, error) {
+	err := st.checkColumns(columns)
+	if err != nil {
+		return 0, err
+	}
+	if len(columns) == 0 {
+		columns = st.GetColumns()
+	}
+
+	rows := dropDuplicates(st.rowMaps(), columns)
+	dropped := len(st.Row) - len(rows)
+	st.setRows(rows)
+	return dropped, nil
+}
@@ -0,0 +1,146 @@
+// Package table define all table types methods.
+// filter.go used to filter table rows.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"regexp"
+	"strings"
+)
+
+// Row type is a mapping of column name and cell. It is passed to a Predicate.
+type Row map[string]cell.Cell
+
+// Get method returns the string value of column. It returns an empty string if the column does not exist.
+func (r Row) Get(column string) string {
+	c, ok := r[column]
+	if !ok {
+		return ""
+	}
+	return c.Original()
+}
+
+// number method returns the float value of column and whether the value is a number.
+func (r Row) number(column string) (float64, bool) {
+	c, ok := r[column]
+	if !ok {
+		return 0, false
+	}
+	return number(c)
+}
+
+// Predicate type reports whether a row matches a condition.
+type Predicate func(row Row) bool
+
+// Eq function returns a Predicate that reports whether the value of column equals value.
+func Eq(column, value string) Predicate {
+	return func(row Row) bool {
+		return row.Get(column) == value
+	}
+}
+
+// Ne function returns a Predicate that reports whether the value of column does not equal value.
+func Ne(column, value string) Predicate {
+	return Not(Eq(column, value))
+}
+
+// Contains function returns a Predicate that reports whether the value of column contains substr.
+func Contains(column, substr string) Predicate {
+	return func(row Row) bool {
+		return strings.Contains(row.Get(column), substr)
+	}
+}
+
+// Prefix function returns a Predicate that reports whether the value of column begins with prefix.
+func Prefix(column, prefix string) Predicate {
+	return func(row Row) bool {
+		return strings.HasPrefix(row.Get(column), prefix)
+	}
+}
+
+// Regex function returns a Predicate that reports whether the value of column matches re.
+func Regex(column string, re *regexp.Regexp) Predicate {
+	return func(row Row) bool {
+		return re.MatchString(row.Get(column))
+	}
+}
+
+// Gt function returns a Predicate that reports whether the value of column is a number greater than number.
+func Gt(column string, number float64) Predicate {
+	return func(row Row) bool {
+		value, ok := row.number(column)
+		return ok && value > number
+	}
+}
+
+// Ge function returns a Predicate that reports whether the value of column is a number greater than or equal to
+// number.
+func Ge(column string, number float64) Predicate {
+	return func(row Row) bool {
+		value, ok := row.number(column)
+		return ok && value >= number
+	}
+}
+
+// Lt function returns a Predicate that reports whether the value of column is a number less than number.
+func Lt(column string, number float64) Predicate {
+	return func(row Row) bool {
+		value, ok := row.number(column)
+		return ok && value < number
+	}
+}
+
+// Le function returns a Predicate that reports whether the value of column is a number less than or equal to number.
+func Le(column string, number float64) Predicate {
+	return func(row Row) bool {
+		value, ok := row.number(column)
+		return ok && value <= number
+	}
+}
+
+// And function returns a Predicate that reports whether a row matches all predicates.
+func And(predicates ...Predicate) Predicate {
+	return func(row Row) bool {
+		for _, predicate := range predicates {
+			if !predicate(row) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or function returns a Predicate that reports whether a row matches any of predicates.
+func Or(predicates ...Predicate) Predicate {
+	return func(row Row) bool {
+		for _, predicate := range predicates {
+			if predicate(row) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not function returns a Predicate that reports whether a row does not match predicate.
+func Not(predicate Predicate) Predicate {
+	return func(row Row) bool {
+		return !predicate(row)
+	}
+}
+
+// Filter method returns a new table that contains the rows matching predicate. The new table has a copy of the
+// columns, so the alignment, default values, colors and other settings are preserved.
+func (tb *Table) Filter(predicate func(row Row) bool) *Table {
+	result := &Table{
+		base: tb.base.copy(),
+		Row:  make([]map[string]cell.Cell, 0),
+	}
+
+	for _, row := range tb.Row {
+		if predicate(row) {
+			result.Row = append(result.Row, copyRow(row))
+		}
+	}
+	return result
+}
@@ -0,0 +1,204 @@
+// Package table define all table types methods.
+// footer.go used to print a footer row of column aggregations.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"strconv"
+	"strings"
+)
+
+// Aggregate type computes the footer value of a column from the cells of the column, null values are excluded.
+// Sum, Avg, Min, Max, Count and CountDistinct are provided, a custom function can be used as well.
+type Aggregate func(cells []cell.Cell) cell.Cell
+
+// Sum function returns the sum of the numbers of the column, values that are not numbers are ignored. The sum of a
+// column of integers is an integer.
+func Sum(cells []cell.Cell) cell.Cell {
+	var sum float64
+	var intSum int64
+	integer := true
+	for _, c := range cells {
+		if v, ok := c.Value().(int64); ok {
+			intSum += v
+			sum += float64(v)
+			continue
+		}
+		if value, ok := number(c); ok {
+			sum += value
+			integer = false
+		}
+	}
+
+	if integer {
+		return createIntCell(intSum)
+	}
+	return createFloatCell(sum)
+}
+
+// Avg function returns the average of the numbers of the column, values that are not numbers are ignored. It returns
+// a null value if the column has no number.
+func Avg(cells []cell.Cell) cell.Cell {
+	var sum float64
+	count := 0
+	for _, c := range cells {
+		if value, ok := number(c); ok {
+			sum += value
+			count++
+		}
+	}
+
+	if count == 0 {
+		return cell.CreateNull()
+	}
+	return createFloatCell(sum / float64(count))
+}
+
+// Min function returns the least value of the column, values are compared by the type of the column. It returns a
+// null value if the column is empty.
+func Min(cells []cell.Cell) cell.Cell {
+	return extreme(cells, -1)
+}
+
+// Max function returns the greatest value of the column, values are compared by the type of the column. It returns a
+// null value if the column is empty.
+func Max(cells []cell.Cell) cell.Cell {
+	return extreme(cells, 1)
+}
+
+// Count function returns the number of values of the column.
+func Count(cells []cell.Cell) cell.Cell {
+	return createIntCell(int64(len(cells)))
+}
+
+// CountDistinct function returns the number of distinct values of the column.
+func CountDistinct(cells []cell.Cell) cell.Cell {
+	distinct := make(map[string]struct{})
+	for _, c := range cells {
+		distinct[c.Original()] = struct{}{}
+	}
+	return createIntCell(int64(len(distinct)))
+}
+
+// Label function returns an Aggregate that always returns text, e.g. the "Total" label of the footer.
+func Label(text string) Aggregate {
+	return func([]cell.Cell) cell.Cell {
+		return cell.CreateData(text)
+	}
+}
+
+func extreme(cells []cell.Cell, sign int) cell.Cell {
+	var result cell.Cell = cell.CreateNull()
+	for index, c := range cells {
+		if index == 0 || typeCompare(c, result)*sign > 0 {
+			result = c
+		}
+	}
+	return result
+}
+
+// number function returns the float value of c and whether the value is a number.
+func number(c cell.Cell) (float64, bool) {
+	switch v := c.Value().(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case nil:
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(c.Original()), 64)
+	return value, err == nil
+}
+
+func createIntCell(value int64) cell.Cell {
+	c, _ := cell.CreateInt(strconv.FormatInt(value, 10))
+	return c
+}
+
+func createFloatCell(value float64) cell.Cell {
+	c, _ := cell.CreateFloat(strconv.FormatFloat(value, 'f', -1, 64))
+	return c
+}
+
+// SetFooter method used to print the result of aggregate under column in the footer row, e.g. table.Sum. The footer
+// row is printed under the last row when at least one column has an aggregate. Passing a nil aggregate removes the
+// aggregate of column.
+// Error:
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (b *base) SetFooter(column string, aggregate Aggregate) error {
+	if !b.Columns.Exist(column) {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	if aggregate == nil {
+		delete(b.footer, column)
+		return nil
+	}
+
+	if b.footer == nil {
+		b.footer = make(map[string]Aggregate)
+	}
+	b.footer[column] = aggregate
+	return nil
+}
+
+// ClearFooter method used to remove the footer row.
+func (b *base) ClearFooter() {
+	b.footer = nil
+}
+
+// ExportFooter method used to control whether the footer row is written as the last record of the JSON and CSV
+// exports. It is not written by default.
+func (b *base) ExportFooter(export bool) {
+	b.exportFooter = export
+}
+
+// footerRow method returns the footer cells computed over rows in the order of the columns. A column without aggregate
+// has an empty cell. It returns nil if there is no footer.
+func (b *base) footerRow(rows [][]cell.Cell) []cell.Cell {
+	if len(b.footer) == 0 {
+		return nil
+	}
+
+	footer := make([]cell.Cell, 0, b.Columns.Len())
+	for index, column := range b.Columns.base {
+		aggregate, ok := b.footer[column.Original()]
+		if !ok {
+			footer = append(footer, cell.CreateEmptyData())
+			continue
+		}
+
+		cells := make([]cell.Cell, 0, len(rows))
+		for _, row := range rows {
+			c := row[index]
+			if colored, ok := c.(*cell.Colored); ok {
+				c = colored.Cell
+			}
+			if _, ok := c.(*cell.Null); !ok {
+				cells = append(cells, c)
+			}
+		}
+		footer = append(footer, aggregate(cells))
+	}
+	return footer
+}
+
+// footerLengths method returns lengths widened by the lengths of the footer cells.
+func (b *base) footerLengths(footer []cell.Cell, lengths []int) []int {
+	if footer == nil {
+		return lengths
+	}
+
+	widened := make([]int, 0, len(lengths))
+	for index, column := range b.Columns.base {
+		length := max(lengths[index], footer[index].Length())
+		if column.MaxWidth() > 0 && length > column.MaxWidth() {
+			length = max(lengths[index], column.MaxWidth())
+		}
+		widened = append(widened, length)
+	}
+	return widened
+}
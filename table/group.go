@@ -0,0 +1,75 @@
+// Package table define all table types methods.
+// group.go used to separate and group the rows of table.
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"strings"
+)
+
+// SetRowSeparator method used to control whether a separator line(the Separator line of the border style) is printed
+// between every two rows.
+func (b *base) SetRowSeparator(enable bool) {
+	b.rowSeparator = enable
+}
+
+// GroupBy method used to print the rows in groups of column. A section header "column: value" and a separator line
+// are printed whenever the value of column changes, so the rows should be sorted by column first(see SortBy). If merge
+// is true, the cells of column are left blank in all rows of a group since the value is printed in the section header.
+// The group is removed when column is dropped.
+// Error:
+// - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+func (b *base) GroupBy(column string, merge bool) error {
+	if !b.Columns.Exist(column) {
+		return exception.ColumnDoNotExist(column)
+	}
+
+	b.groupBy = column
+	b.mergeGroup = merge
+	return nil
+}
+
+// Ungroup method used to print the rows without groups.
+func (b *base) Ungroup() {
+	b.groupBy = ""
+	b.mergeGroup = false
+}
+
+// printBody method writes the row at index, previous is the row printed before it and is nil for the first row. The
+// row separator and the section header of a new group are written before the row.
+func (b *base) printBody(p *printer, row, previous []cell.Cell, index int, lengths []int) {
+	group := -1
+	if b.groupBy != "" {
+		group = b.Columns.exist(b.groupBy)
+	}
+
+	newGroup := group != -1 && (previous == nil || row[group].Original() != previous[group].Original())
+	if previous != nil && (b.rowSeparator || newGroup) && b.border {
+		b.printBorder(p, b.style.Separator, lengths)
+	}
+
+	if newGroup {
+		b.printSection(p, fmt.Sprintf("%s: %s", b.groupBy, row[group].Original()), lengths)
+	}
+	if group != -1 && b.mergeGroup {
+		row = append([]cell.Cell(nil), row...)
+		row[group] = cell.CreateEmptyData()
+	}
+	b.printRow(p, b.themeRow(row, index), lengths)
+}
+
+// printSection method writes a section header which spans all columns, followed by a separator line.
+func (b *base) printSection(p *printer, title string, lengths []int) {
+	icon := " "
+	if b.border {
+		icon = b.paintBorder(b.style.Vertical)
+	}
+
+	s, _ := left(cell.CreateData(" "+title), b.tableWidth(lengths)-2, " ")
+	p.line(strings.Join([]string{icon, s, icon}, ""))
+	if b.border {
+		b.printBorder(p, b.style.Separator, lengths)
+	}
+}
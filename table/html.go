@@ -0,0 +1,117 @@
+// Package table define all table types methods.
+// html.go used to export table as a html table.
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"html"
+	"strings"
+)
+
+// html method returns a html table of the columns and the given rows, each row holds the cells in the order of the
+// columns. The indent argument represents the indent value. If indent is less than zero, it is treated as zero.
+func (b *base) html(rows [][]cell.Cell, indent int) string {
+	if indent < 0 {
+		indent = 0
+	}
+	indentString := strings.Repeat(" ", indent)
+
+	contents := []string{"<table>"}
+	if b.title != "" {
+		contents = append(contents, fmt.Sprintf("<caption>%s</caption>", htmlEscape(b.title)))
+	}
+	contents = append(contents, "<thead>")
+	if spans := b.Columns.spans(); spans != nil {
+		contents = append(contents, indentString+"<tr>")
+		for _, span := range spans {
+			line := "<th></th>"
+			if span.label != "" {
+				line = fmt.Sprintf("<th colspan=\"%d\" style=\"text-align:center\">%s</th>", span.count,
+					htmlEscape(span.label))
+			}
+			contents = append(contents, indentString+indentString+line)
+		}
+		contents = append(contents, indentString+"</tr>")
+	}
+	contents = append(contents, indentString+"<tr>")
+	for _, column := range b.Columns.base {
+		style := htmlAlign(column)
+		if column.Color() != nil {
+			style += ";" + column.Color().CSS()
+		}
+		line := fmt.Sprintf("<th style=\"%s\">%s</th>", style, htmlEscape(column.Original()))
+		contents = append(contents, indentString+indentString+line)
+	}
+	contents = append(contents, indentString+"</tr>", "</thead>", "<tbody>")
+
+	for _, row := range rows {
+		contents = append(contents, indentString+"<tr>")
+		for index, c := range row {
+			style := htmlAlign(b.Columns.base[index])
+			if colored, ok := c.(*cell.Colored); ok {
+				style += ";" + colored.Color().CSS()
+			}
+			line := fmt.Sprintf("<td style=\"%s\">%s</td>", style, htmlEscape(c.Original()))
+			contents = append(contents, indentString+indentString+line)
+		}
+		contents = append(contents, indentString+"</tr>")
+	}
+	contents = append(contents, "</tbody>")
+
+	if b.caption != "" {
+		line := fmt.Sprintf("<td colspan=\"%d\">%s</td>", b.Columns.Len(), htmlEscape(b.caption))
+		contents = append(contents, "<tfoot>", indentString+"<tr>", indentString+indentString+line, indentString+"</tr>",
+			"</tfoot>")
+	}
+	contents = append(contents, "</table>")
+	return strings.Join(contents, "\n")
+}
+
+func htmlAlign(column *cell.Column) string {
+	return "text-align:" + column.AlignString()
+}
+
+func htmlEscape(value string) string {
+	return strings.Replace(html.EscapeString(value), "\n", "<br>", -1)
+}
+
+// The HTML method returns the html table string corresponding to the gotable. The indent argument represents the
+// indent value. If indent is less than zero, the HTML method treats it as zero.
+// The alignment of each column is translated to text-align, and the column color is translated to inline css. The
+// title is written as the caption element and the caption is written as a row of the tfoot element. The header groups
+// are written as a header row with colspan.
+func (tb *Table) HTML(indent int) string {
+	return tb.html(tb.rows(), indent)
+}
+
+// ToHTMLFile method used to save the table as a html table into path.
+// Error:
+// - If path is not a html file(*.html or *.htm), an *exception.NotARegularHTMLFileError is returned.
+func (tb *Table) ToHTMLFile(path string, indent int) error {
+	if !util.IsHTMLFile(path) {
+		return exception.NotARegularHTMLFile(path)
+	}
+	return writeFile(path, tb.HTML(indent)+"\n")
+}
+
+// The HTML method returns the html table string corresponding to the gotable. The indent argument represents the
+// indent value. If indent is less than zero, the HTML method treats it as zero.
+// The alignment of each column is translated to text-align, and the column color is translated to inline css. The
+// title is written as the caption element and the caption is written as a row of the tfoot element. The header groups
+// are written as a header row with colspan.
+func (st *SafeTable) HTML(indent int) string {
+	return st.html(st.rows(), indent)
+}
+
+// ToHTMLFile method used to save the table as a html table into path.
+// Error:
+// - If path is not a html file(*.html or *.htm), an *exception.NotARegularHTMLFileError is returned.
+func (st *SafeTable) ToHTMLFile(path string, indent int) error {
+	if !util.IsHTMLFile(path) {
+		return exception.NotARegularHTMLFile(path)
+	}
+	return writeFile(path, st.HTML(indent)+"\n")
+}
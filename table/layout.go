@@ -0,0 +1,152 @@
+// Package table define all table types methods.
+// layout.go used to fit the table into a given width.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/util"
+)
+
+// minColumnWidth is the width that a column is not shrunk below when fitting the table into a width.
+const minColumnWidth = 3
+
+// SetFitWidth method used to print the table in at most width terminal columns. The widest columns are shrunk, their
+// values are wrapped or truncated by the wrap mode of the column(see SetColumnMaxWidth). If hide is true, the columns
+// with the lowest priority are hidden when the table can not fit the width otherwise. If width is not greater than 0,
+// the table is printed in full width.
+func (b *base) SetFitWidth(width int, hide bool) {
+	b.fitWidth = width
+	b.fitTerminal = false
+	b.hideColumns = hide
+}
+
+// FitTerminal method used to print the table in the width of the terminal, the width is detected every time the
+// table is printed(see util.TerminalWidth). The hide argument is the same as SetFitWidth.
+func (b *base) FitTerminal(hide bool) {
+	b.fitWidth = 0
+	b.fitTerminal = true
+	b.hideColumns = hide
+}
+
+// SetColumnPriority method used to set the priority of column, a column with lower priority is hidden first when the
+// table does not fit the width. The default priority is 0.
+func (b *base) SetColumnPriority(column string, priority int) {
+	col := b.Columns.Get(column)
+	if col != nil {
+		col.SetPriority(priority)
+	}
+}
+
+func (b *base) targetWidth() int {
+	if b.fitTerminal {
+		return util.TerminalWidth()
+	}
+	return b.fitWidth
+}
+
+// tableWidth method returns the width of the table printed with lengths.
+func (b *base) tableWidth(lengths []int) int {
+	width := len(lengths) + 1
+	for _, length := range lengths {
+		width += length
+		if b.border {
+			width += 2
+		}
+	}
+	return width
+}
+
+// fit method returns the table, rows, footer and lengths used to print the table in the fit width. If some columns
+// are hidden, the returned table is a view which contains the visible columns only.
+func (b *base) fit(rows [][]cell.Cell, footer []cell.Cell, lengths []int) (*base, [][]cell.Cell, []cell.Cell, []int) {
+	width := b.targetWidth()
+	if width <= 0 || b.tableWidth(lengths) <= width {
+		return b, rows, footer, lengths
+	}
+
+	visible := make([]int, 0, len(lengths))
+	for index := range lengths {
+		visible = append(visible, index)
+	}
+
+	minimums := make([]int, 0, len(lengths))
+	for _, length := range lengths {
+		if length > minColumnWidth {
+			length = minColumnWidth
+		}
+		minimums = append(minimums, length)
+	}
+
+	if b.hideColumns {
+		for len(visible) > 1 && b.tableWidth(pickLengths(minimums, visible)) > width {
+			visible = b.hideColumn(visible)
+		}
+	}
+
+	fitted := pickLengths(lengths, visible)
+	minimums = pickLengths(minimums, visible)
+	for b.tableWidth(fitted) > width {
+		widest := -1
+		for index := range fitted {
+			if fitted[index] > minimums[index] && (widest == -1 || fitted[index] > fitted[widest]) {
+				widest = index
+			}
+		}
+		if widest == -1 {
+			break
+		}
+		fitted[widest]--
+	}
+
+	if len(visible) == len(lengths) {
+		return b, rows, footer, fitted
+	}
+
+	view := *b
+	view.Columns = &Set{base: make([]*cell.Column, 0, len(visible)), groups: b.Columns.groups}
+	for _, index := range visible {
+		view.Columns.base = append(view.Columns.base, b.Columns.base[index])
+	}
+
+	viewRows := make([][]cell.Cell, 0, len(rows))
+	for _, row := range rows {
+		viewRows = append(viewRows, pickCells(row, visible))
+	}
+	if footer != nil {
+		footer = pickCells(footer, visible)
+	}
+	return &view, viewRows, footer, fitted
+}
+
+// hideColumn method removes the column with the lowest priority from visible, the rightmost one is removed if
+// several columns have the lowest priority.
+func (b *base) hideColumn(visible []int) []int {
+	lowest := len(visible) - 1
+	for position := len(visible) - 1; position >= 0; position-- {
+		if b.Columns.base[visible[position]].Priority() < b.Columns.base[visible[lowest]].Priority() {
+			lowest = position
+		}
+	}
+
+	result := make([]int, 0, len(visible)-1)
+	result = append(result, visible[:lowest]...)
+	return append(result, visible[lowest+1:]...)
+}
+
+// pickLengths function returns the lengths at indexes.
+func pickLengths(lengths []int, indexes []int) []int {
+	picked := make([]int, 0, len(indexes))
+	for _, index := range indexes {
+		picked = append(picked, lengths[index])
+	}
+	return picked
+}
+
+// pickCells function returns the cells of row at indexes.
+func pickCells(row []cell.Cell, indexes []int) []cell.Cell {
+	picked := make([]cell.Cell, 0, len(indexes))
+	for _, index := range indexes {
+		picked = append(picked, row[index])
+	}
+	return picked
+}
@@ -0,0 +1,87 @@
+// Package table define all table types methods.
+// markdown.go used to export table as a GitHub-flavored markdown table.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+// markdown method returns a markdown table of the columns and the given rows, each row holds the cells in the order
+// of the columns.
+func (b *base) markdown(rows [][]cell.Cell) string {
+	lines := make([]string, 0, len(rows)+6)
+	if b.title != "" {
+		lines = append(lines, "**"+markdownEscaper.Replace(b.title)+"**", "")
+	}
+
+	names := make([]string, 0, b.Columns.Len())
+	aligns := make([]string, 0, b.Columns.Len())
+	for _, column := range b.Columns.base {
+		names = append(names, markdownEscaper.Replace(column.Original()))
+		switch column.Align() {
+		case L:
+			aligns = append(aligns, ":---")
+		case R:
+			aligns = append(aligns, "---:")
+		default:
+			aligns = append(aligns, ":---:")
+		}
+	}
+	lines = append(lines, markdownLine(names), markdownLine(aligns))
+
+	for _, row := range rows {
+		values := make([]string, 0, len(row))
+		for _, c := range row {
+			values = append(values, markdownEscaper.Replace(c.Original()))
+		}
+		lines = append(lines, markdownLine(values))
+	}
+
+	if b.caption != "" {
+		lines = append(lines, "", "_"+markdownEscaper.Replace(b.caption)+"_")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func markdownLine(values []string) string {
+	return "| " + strings.Join(values, " | ") + " |"
+}
+
+// Markdown method returns a GitHub-flavored markdown table. The alignment of each column is kept in the delimiter row,
+// pipes in values are escaped and newlines are replaced with <br>. The title is written in bold above the table and
+// the caption in italics under the table.
+func (tb *Table) Markdown() string {
+	return tb.markdown(tb.rows())
+}
+
+// ToMarkdownFile method used to save the table as a markdown table into path.
+// Error:
+// - If path is not a markdown file(*.md), an *exception.NotARegularMarkdownFileError is returned.
+func (tb *Table) ToMarkdownFile(path string) error {
+	if !util.IsMarkdownFile(path) {
+		return exception.NotARegularMarkdownFile(path)
+	}
+	return writeFile(path, tb.Markdown()+"\n")
+}
+
+// Markdown method returns a GitHub-flavored markdown table. The alignment of each column is kept in the delimiter row,
+// pipes in values are escaped and newlines are replaced with <br>. The title is written in bold above the table and
+// the caption in italics under the table.
+func (st *SafeTable) Markdown() string {
+	return st.markdown(st.rows())
+}
+
+// ToMarkdownFile method used to save the table as a markdown table into path.
+// Error:
+// - If path is not a markdown file(*.md), an *exception.NotARegularMarkdownFileError is returned.
+func (st *SafeTable) ToMarkdownFile(path string) error {
+	if !util.IsMarkdownFile(path) {
+		return exception.NotARegularMarkdownFile(path)
+	}
+	return writeFile(path, st.Markdown()+"\n")
+}
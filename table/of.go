@@ -0,0 +1,51 @@
+// Package table define all table types methods.
+// of.go defines the generic table of struct rows.
+package table
+
+// Of struct is a table whose rows are values of struct type T, the columns are the fields of T mapped by the gotable
+// tag(see CreateSetFromStruct). It embeds *Table, so that it is printed and exported the same as a table.
+type Of[T any] struct {
+	*Table
+}
+
+// CreateOf function creates an empty table from struct type T, T is a struct or a pointer to struct.
+// Error:
+// - If T is not a struct or the struct has no column, an *exception.ColumnsLengthError is returned.
+// - If columns contain duplicate values, an error is returned.
+func CreateOf[T any]() (*Of[T], error) {
+	var zero T
+	set, err := CreateSetFromStruct(&zero)
+	if err != nil {
+		return nil, err
+	}
+	return &Of[T]{Table: CreateTable(set)}, nil
+}
+
+// Add method adds row to the table.
+// Error:
+// - If row is a nil pointer, an *exception.UnsupportedRowTypeError is returned.
+// - If a value can not be converted to the type of its column, an *exception.ValueTypeError is returned.
+func (o *Of[T]) Add(row T) error {
+	return o.AddRow(&row)
+}
+
+// AddAll method adds rows to the table in order until an error occurs, the error is returned.
+func (o *Of[T]) AddAll(rows []T) error {
+	for _, row := range rows {
+		err := o.Add(row)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rows method returns the rows of the table as values of T(see Scan).
+func (o *Of[T]) Rows() ([]T, error) {
+	rows := make([]T, 0, o.Length())
+	err := o.Scan(&rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,146 @@
+// Package table define all table types methods.
+// page.go used to print part of table rows.
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"strings"
+)
+
+// sliceRange function returns start and end clamped to [0, length], end is not less than start.
+func sliceRange(start, end, length int) (int, int) {
+	if start < 0 {
+		start = 0
+	}
+	if end < 0 {
+		end = 0
+	}
+	if end > length {
+		end = length
+	}
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+// Slice method returns a new table that contains the rows in [start, end). The range is clamped to the table length.
+// The new table has a copy of the columns and the same settings.
+func (tb *Table) Slice(start, end int) *Table {
+	start, end = sliceRange(start, end, tb.Length())
+	result := &Table{
+		base: tb.base.copy(),
+		Row:  make([]map[string]cell.Cell, 0, end-start),
+	}
+
+	for _, row := range tb.Row[start:end] {
+		result.Row = append(result.Row, copyRow(row))
+	}
+	return result
+}
+
+// Head method returns a new table that contains the first n rows.
+func (tb *Table) Head(n int) *Table {
+	return tb.Slice(0, n)
+}
+
+// Tail method returns a new table that contains the last n rows.
+func (tb *Table) Tail(n int) *Table {
+	return tb.Slice(tb.Length()-n, tb.Length())
+}
+
+// Slice method returns a new table that contains the rows in [start, end). The range is clamped to the table length.
+// The new table has a copy of the columns and the same settings.
+func (st *SafeTable) Slice(start, end int) *SafeTable {
+	start, end = sliceRange(start, end, st.Length())
+	result := &SafeTable{base: st.base.copy()}
+	result.setRows(st.rowMaps()[start:end])
+	return result
+}
+
+// Head method returns a new table that contains the first n rows.
+func (st *SafeTable) Head(n int) *SafeTable {
+	return st.Slice(0, n)
+}
+
+// Tail method returns a new table that contains the last n rows.
+func (st *SafeTable) Tail(n int) *SafeTable {
+	return st.Slice(st.Length()-n, st.Length())
+}
+
+// Pager struct used to print a table page by page.
+//   - StableWidth: Use the column widths of the whole table for every page, so that widths do not change between pages.
+//     By default, the column widths are computed from the rows of the page.
+type Pager struct {
+	table       *base
+	rows        func() [][]cell.Cell
+	size        int
+	StableWidth bool
+}
+
+// Pager method returns a *Pager that prints size rows per page. If size is not greater than 0, all rows are printed
+// in one page.
+func (tb *Table) Pager(size int) *Pager {
+	return &Pager{table: tb.base, rows: tb.rows, size: size}
+}
+
+// Pager method returns a *Pager that prints size rows per page. If size is not greater than 0, all rows are printed
+// in one page.
+func (st *SafeTable) Pager(size int) *Pager {
+	return &Pager{table: st.base, rows: st.rows, size: size}
+}
+
+// Size method returns the number of rows per page.
+func (p *Pager) Size() int {
+	return p.size
+}
+
+// Pages method returns the number of pages, an empty table has one page.
+func (p *Pager) Pages() int {
+	return p.pages(len(p.rows()))
+}
+
+func (p *Pager) pages(length int) int {
+	if p.size <= 0 || length == 0 {
+		return 1
+	}
+	return (length + p.size - 1) / p.size
+}
+
+// Page method returns the page which number is page, page number starts from 1. A footer "rows x–y of z" is printed
+// under the table.
+// Error:
+// - If page is out of range, an *exception.PageOutOfRangeError is returned.
+func (p *Pager) Page(page int) (string, error) {
+	rows := p.rows()
+	pages := p.pages(len(rows))
+	if page < 1 || page > pages {
+		return "", exception.PageOutOfRange(page, pages)
+	}
+
+	start, end := 0, len(rows)
+	if p.size > 0 {
+		start, end = sliceRange((page-1)*p.size, page*p.size, len(rows))
+	}
+
+	lengths := p.table.columnLengths(rows[start:end])
+	if p.StableWidth {
+		lengths = p.table.columnLengths(rows)
+	}
+
+	first := start + 1
+	if start == end {
+		first = start
+	}
+
+	var builder strings.Builder
+	printer := newPrinter(&builder)
+	footer := p.table.footerRow(rows)
+	view, pageRows, footer, lengths := p.table.fit(rows[start:end], footer, p.table.footerLengths(footer, lengths))
+	view.print(printer, pageRows, footer, lengths)
+	printer.line(fmt.Sprintf("rows %d–%d of %d", first, end, len(rows)))
+	printer.close(p.table.End)
+	return builder.String(), nil
+}
@@ -3,96 +3,384 @@
 package table
 
 import (
+	"bufio"
 	"fmt"
 	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/color"
+	"github.com/liushuochen/gotable/exception"
+	"github.com/liushuochen/gotable/util"
+	"io"
+	"strings"
 	"sync"
 )
 
-// This method print part of table data in STDOUT. It will be called twice in *table.PrintTable method.
-// Arguments:
-//   group: 		A map that storage column as key, data as value. Data is either "-" or row, if the value of data is
-//                  "-", the printGroup method will print the border of the table.
-//   columnMaxLen:  A map that storage column as key, max length of cell of column as value.
-func (tb *Table) printGroup(group []map[string]cell.Cell, columnMaxLen map[string]int) string {
-	result := ""
-	for _, item := range group {
-		for index, column := range tb.Columns.base {
-			itemLen := columnMaxLen[column.Original()]
-			if tb.border {
-				itemLen += 2
-			}
-			s := ""
-			icon := "|"
-			if item[column.String()].String() == "-" {
-				if tb.border {
-					s, _ = center(item[column.String()], itemLen, "-")
-					icon = "+"
-				} else {
-					icon = " "
-				}
-			} else {
-				switch column.Align() {
-				case R:
-					s, _ = right(item[column.String()], itemLen, " ")
-				case L:
-					s, _ = left(item[column.String()], itemLen, " ")
-				default:
-					s, _ = center(item[column.String()], itemLen, " ")
-				}
-			}
+// columnLengths method returns the max length of cell of each column in the order of the columns, the column names
+// are included.
+func (b *base) columnLengths(rows [][]cell.Cell) []int {
+	lengths := make([]int, 0, b.Columns.Len())
+	for _, column := range b.Columns.base {
+		lengths = append(lengths, column.Length())
+	}
 
-			if index == 0 {
-				s = icon + s + icon
-			} else {
-				s = "" + s + icon
-			}
-			result += s
+	for _, row := range rows {
+		for index, c := range row {
+			lengths[index] = max(lengths[index], c.Length())
+		}
+	}
+
+	for index, column := range b.Columns.base {
+		if column.MaxWidth() > 0 && lengths[index] > column.MaxWidth() {
+			lengths[index] = column.MaxWidth()
+		}
+	}
+	b.widenGroups(lengths)
+	return lengths
+}
+
+// widenGroups method widens the last column of each header group, so that the label of the group fits the width of
+// its columns.
+func (b *base) widenGroups(lengths []int) {
+	for _, span := range b.Columns.spans() {
+		width := b.spanWidth(span, lengths)
+		if span.label != "" && util.Length(span.label) > width {
+			lengths[span.start+span.count-1] += util.Length(span.label) - width
 		}
-		result += "\n"
 	}
-	return result
 }
 
-func (st *SafeTable) printGroup(group []*sync.Map, columnMaxLen *sync.Map) string {
-	result := ""
-	for _, item := range group {
-		for index, column := range st.Columns.base {
-			value, _ := columnMaxLen.Load(column.Original())
-			itemLen := value.(int)
-			if st.border {
+// spanWidth method returns the width of the header span between its edges.
+func (b *base) spanWidth(span headerSpan, lengths []int) int {
+	width := span.count - 1
+	for _, length := range lengths[span.start : span.start+span.count] {
+		width += length
+		if b.border {
+			width += 2
+		}
+	}
+	return width
+}
+
+// printer struct writes the lines of a table into w. The line break of the last line is written by the close method,
+// so that it can be replaced by the ending of the table.
+type printer struct {
+	w       io.StringWriter
+	pending bool
+}
+
+func newPrinter(w io.StringWriter) *printer {
+	return &printer{w: w}
+}
+
+// line method writes a line, the line break of the previous line is written first.
+func (p *printer) line(content string) {
+	if p.pending {
+		_, _ = p.w.WriteString("\n")
+	}
+	_, _ = p.w.WriteString(content)
+	p.pending = true
+}
+
+// close method writes end instead of the line break of the last line.
+func (p *printer) close(end string) {
+	if p.pending {
+		_, _ = p.w.WriteString(end)
+	}
+	p.pending = false
+}
+
+// breakLine method writes the line break of the last line, so that the line is complete before the table ends.
+func (p *printer) breakLine() {
+	if p.pending {
+		_, _ = p.w.WriteString("\n")
+	}
+	p.pending = false
+}
+
+// render method returns the table string of the columns and the given rows, the ending of the table is included.
+// The footer is computed over rows and the table is fitted into the fit width(see SetFitWidth).
+func (b *base) render(rows [][]cell.Cell, lengths []int) string {
+	var builder strings.Builder
+	p := newPrinter(&builder)
+	footer := b.footerRow(rows)
+	view, rows, footer, lengths := b.fit(rows, footer, b.footerLengths(footer, lengths))
+	view.print(p, rows, footer, lengths)
+	p.close(b.End)
+	return builder.String()
+}
+
+// writeTo method writes the table of the columns and the given rows into w through a buffer. It returns the number
+// of bytes written and the first error encountered.
+func (b *base) writeTo(w io.Writer, rows [][]cell.Cell) (int64, error) {
+	counter := &countWriter{w: w}
+	buffer := bufio.NewWriter(counter)
+	p := newPrinter(buffer)
+	footer := b.footerRow(rows)
+	view, rows, footer, lengths := b.fit(rows, footer, b.footerLengths(footer, b.columnLengths(rows)))
+	view.print(p, rows, footer, lengths)
+	p.close(b.End)
+	err := buffer.Flush()
+	return counter.n, err
+}
+
+// countWriter struct counts the bytes written into w.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(data []byte) (int, error) {
+	n, err := c.w.Write(data)
+	c.n += int64(n)
+	return n, err
+}
+
+// print method writes the columns and the given rows, each row holds the cells in the order of the columns.
+// - rows: Cells of each row to be printed.
+// - footer: Cells of the footer row, in the order of the columns. The footer is not printed if it is nil.
+// - lengths: Max length of cell of each column, in the order of the columns.
+func (b *base) print(p *printer, rows [][]cell.Cell, footer []cell.Cell, lengths []int) {
+	b.printTitle(p, b.title, lengths)
+	b.printHeader(p, lengths)
+	var previous []cell.Cell
+	for index, row := range rows {
+		b.printBody(p, row, previous, index, lengths)
+		previous = row
+	}
+	if footer != nil {
+		b.printFooter(p, footer, lengths)
+	}
+	if len(rows) > 0 || footer != nil {
+		b.printBottom(p, lengths)
+	}
+	b.printTitle(p, b.caption, lengths)
+}
+
+// printTitle method writes title centered over the width of the table, it is used for the title and the caption.
+// Nothing is written if title is empty.
+func (b *base) printTitle(p *printer, title string, lengths []int) {
+	if title == "" {
+		return
+	}
+
+	for _, line := range util.Lines(title) {
+		s, _ := center(cell.CreateData(line), b.tableWidth(lengths), " ")
+		p.line(strings.TrimRight(s, " "))
+	}
+}
+
+// printHeader method writes the top border line, the column names and the header border line.
+func (b *base) printHeader(p *printer, lengths []int) {
+	if b.border {
+		b.printBorder(p, b.style.Top, lengths)
+	}
+	b.printGroups(p, lengths)
+
+	header := make([]cell.Cell, 0, len(b.Columns.base))
+	for _, column := range b.Columns.base {
+		if column.Color() == nil {
+			header = append(header, b.themeHeader(column))
+		} else {
+			header = append(header, column)
+		}
+	}
+	b.printRow(p, header, lengths)
+
+	if b.border {
+		b.printBorder(p, b.style.Header, lengths)
+	}
+}
+
+// printGroups method writes the labels of the header groups centered over their columns. Nothing is written if there
+// is no header group.
+func (b *base) printGroups(p *printer, lengths []int) {
+	spans := b.Columns.spans()
+	if spans == nil {
+		return
+	}
+
+	icon := " "
+	if b.border {
+		icon = b.paintBorder(b.style.Vertical)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(icon)
+	for _, span := range spans {
+		width := b.spanWidth(span, lengths)
+		var label cell.Cell = cell.CreateData(util.Truncate(span.label, width))
+		if span.label != "" && !b.noColor {
+			label = b.themeHeader(label)
+		}
+		s, _ := center(label, width, " ")
+		builder.WriteString(s)
+		builder.WriteString(icon)
+	}
+	p.line(builder.String())
+}
+
+// printFooter method writes the footer row under a separator line, which is the same as the header border line.
+func (b *base) printFooter(p *printer, footer []cell.Cell, lengths []int) {
+	if b.border {
+		b.printBorder(p, b.style.Header, lengths)
+	}
+
+	cells := make([]cell.Cell, 0, len(footer))
+	for _, c := range footer {
+		if _, ok := c.(*cell.Colored); !ok {
+			c = b.themeHeader(c)
+		}
+		cells = append(cells, c)
+	}
+	b.printRow(p, cells, lengths)
+}
+
+// themeHeader method returns c in the header color of the theme.
+func (b *base) themeHeader(c cell.Cell) cell.Cell {
+	if b.theme == nil || b.theme.Header == nil {
+		return c
+	}
+	return cell.CreateColored(c, b.theme.Header)
+}
+
+// printBottom method writes the bottom border line.
+func (b *base) printBottom(p *printer, lengths []int) {
+	if b.border {
+		b.printBorder(p, b.style.Bottom, lengths)
+	}
+}
+
+// printBorder method writes a horizontal border line. Nothing is written if the line is invisible.
+func (b *base) printBorder(p *printer, line BorderLine, lengths []int) {
+	if !line.visible() {
+		return
+	}
+
+	var builder strings.Builder
+	builder.WriteString(line.Left)
+	for index, length := range lengths {
+		builder.WriteString(strings.Repeat(line.Fill, length+2))
+		if index == len(lengths)-1 {
+			builder.WriteString(line.Right)
+		} else {
+			builder.WriteString(line.Junction)
+		}
+	}
+	p.line(b.paintBorder(builder.String()))
+}
+
+// paintBorder method returns border in the border color of the theme.
+func (b *base) paintBorder(border string) string {
+	if b.noColor || b.theme == nil || b.theme.Border == nil {
+		return border
+	}
+	return b.theme.Border.Combine(border)
+}
+
+// themeRow method returns the cells of the row at index in the row colors of the theme, the colored cells are not
+// changed.
+func (b *base) themeRow(row []cell.Cell, index int) []cell.Cell {
+	if b.theme == nil {
+		return row
+	}
+
+	c := b.theme.Row
+	if index%2 == 1 {
+		c = b.theme.AlternateRow
+	}
+	if c == nil {
+		return row
+	}
+
+	cells := make([]cell.Cell, 0, len(row))
+	for _, value := range row {
+		if _, ok := value.(*cell.Colored); !ok {
+			value = cell.CreateColored(value, c)
+		}
+		cells = append(cells, value)
+	}
+	return cells
+}
+
+// printRow method writes a row. A row takes several lines if a cell contains line breaks or is longer than the max
+// width of its column.
+// - row: Cells of the row, in the order of the columns.
+// - lengths: Max length of cell of each column, in the order of the columns.
+func (b *base) printRow(p *printer, row []cell.Cell, lengths []int) {
+	icon := " "
+	if b.border {
+		icon = b.paintBorder(b.style.Vertical)
+	}
+
+	lines := make([][]cell.Cell, 0, len(row))
+	height := 1
+	for index, column := range b.Columns.base {
+		lines = append(lines, b.cellLines(row[index], column, lengths[index]))
+		height = max(height, len(lines[index]))
+	}
+
+	for line := 0; line < height; line++ {
+		var builder strings.Builder
+		for index, column := range b.Columns.base {
+			itemLen := lengths[index]
+			if b.border {
 				itemLen += 2
 			}
+
+			var c cell.Cell = cell.CreateEmptyData()
+			if line < len(lines[index]) {
+				c = lines[index][line]
+			}
+
 			s := ""
-			icon := "|"
-			value, _ = item.Load(column.String())
-			if value.(cell.Cell).String() == "-" {
-				if st.border {
-					s, _ = center(value.(cell.Cell), itemLen, "-")
-					icon = "+"
-				} else {
-					icon = " "
-				}
-			} else {
-				switch column.Align() {
-				case R:
-					s, _ = right(value.(cell.Cell), itemLen, " ")
-				case L:
-					s, _ = left(value.(cell.Cell), itemLen, " ")
-				default:
-					s, _ = center(value.(cell.Cell), itemLen, " ")
-				}
+			switch column.Align() {
+			case R:
+				s, _ = right(c, itemLen, " ")
+			case L:
+				s, _ = left(c, itemLen, " ")
+			default:
+				s, _ = center(c, itemLen, " ")
 			}
 
 			if index == 0 {
-				s = icon + s + icon
-			} else {
-				s = "" + s + icon
+				builder.WriteString(icon)
 			}
-			result += s
+			builder.WriteString(s)
+			builder.WriteString(icon)
+		}
+		p.line(builder.String())
+	}
+}
+
+// cellLines method splits c into display lines by line breaks and the width of column, a longer line is handled by the
+// wrap mode of column. The color of a colored cell or column is applied to every line.
+func (b *base) cellLines(c cell.Cell, column *cell.Column, width int) []cell.Cell {
+	var painter *color.Color
+	text := c.String()
+	switch v := c.(type) {
+	case *cell.Colored:
+		painter, text = v.Color(), v.Original()
+	case *cell.Column:
+		painter, text = v.Color(), v.Original()
+	}
+
+	if b.noColor {
+		painter, text = nil, util.StripANSI(text)
+		c = cell.CreateData(text)
+	}
+
+	if !strings.ContainsAny(text, "\r\n") && c.Length() <= width {
+		return []cell.Cell{c}
+	}
+
+	lines := make([]cell.Cell, 0)
+	for _, line := range column.Wrap(text, width) {
+		var value cell.Cell = cell.CreateData(line)
+		if painter != nil {
+			value = cell.CreateColored(value, painter)
 		}
-		result += "\n"
+		lines = append(lines, value)
 	}
-	return result
+	return lines
 }
 
 func max(x, y int) int {
@@ -113,24 +401,12 @@ func center(c cell.Cell, length int, fillchar string) (string, error) {
 		return c.String(), nil
 	}
 
-	result := ""
-	if isEvenNumber(length - c.Length()) {
-		front := ""
-		for i := 0; i < ((length - c.Length()) / 2); i++ {
-			front = front + fillchar
-		}
-
-		result = front + c.String() + front
-	} else {
-		front := ""
-		for i := 0; i < ((length - c.Length() - 1) / 2); i++ {
-			front = front + fillchar
-		}
-
-		behind := front + fillchar
-		result = front + c.String() + behind
+	front := strings.Repeat(fillchar, (length-c.Length())/2)
+	behind := front
+	if !isEvenNumber(length - c.Length()) {
+		behind += fillchar
 	}
-	return result, nil
+	return front + c.String() + behind, nil
 }
 
 func left(c cell.Cell, length int, fillchar string) (string, error) {
@@ -156,11 +432,10 @@ func right(c cell.Cell, length int, fillchar string) (string, error) {
 }
 
 func block(length int) string {
-	result := ""
-	for i := 0; i < length; i++ {
-		result += " "
+	if length <= 0 {
+		return ""
 	}
-	return result
+	return strings.Repeat(" ", length)
 }
 
 func isEvenNumber(number int) bool {
@@ -170,18 +445,32 @@ func isEvenNumber(number int) bool {
 	return false
 }
 
-func toRow(value map[string]string) map[string]cell.Cell {
+// toRow function converts value to a row, each cell is created according to the type of its column.
+// It returns an *exception.ValueTypeError if a value can not be converted to the type of its column.
+func toRow(value map[string]string, columns *Set) (map[string]cell.Cell, error) {
 	row := make(map[string]cell.Cell)
 	for k, v := range value {
-		row[k] = cell.CreateData(v)
+		column := columns.Get(k)
+		c, err := column.CreateCell(v)
+		if err != nil {
+			return nil, exception.ValueType(k, v, column.TypeString())
+		}
+		row[k] = c
+	}
+	return row, nil
+}
+
+// copyRow function returns a new row which contains the same cells as row.
+func copyRow(row map[string]cell.Cell) map[string]cell.Cell {
+	copied := make(map[string]cell.Cell, len(row))
+	for column, value := range row {
+		copied[column] = value
 	}
-	return row
+	return copied
 }
 
-func toSafeRow(value map[string]string) sync.Map {
-	var row sync.Map
+func toSafeRow(value map[string]cell.Cell, row *sync.Map) {
 	for k, v := range value {
-		row.Store(k, cell.CreateData(v))
+		row.Store(k, v)
 	}
-	return row
 }
@@ -44,6 +44,10 @@ func (st *SafeTable) AddColumn(column string) error {
 //   exist, the AddRow method returns an error.
 // For Slice argument, you must ensure that the slice length is equal to the column length. Method will automatically
 //   map values in Slice and columns. The default value cannot be omitted and must use gotable.Default constant.
+// For Struct argument(a struct, a pointer to struct, or a slice of them), each field is mapped to the column named by
+//   the gotable tag, e.g. `gotable:"name,omitempty,default=N/A,align=right"`. A fmt.Stringer is added by its String
+//   method, embedded and nested structs are flattened(see CreateSetFromStruct). The rows of a slice are added in
+//   order until an error occurs.
 // Return error types:
 //   - *exception.UnsupportedRowTypeError: It returned when the type of the argument is not supported.
 //   - *exception.RowLengthNotEqualColumnsError: It returned if the argument is type of the Slice but the length is
@@ -57,7 +61,17 @@ func (st *SafeTable) AddRow(row interface{}) error {
 	case map[string]string:
 		return st.addRowFromMap(v)
 	default:
-		return exception.UnsupportedRowType(v)
+		rows, ok := structRows(v)
+		if !ok {
+			return exception.UnsupportedRowType(v)
+		}
+		for _, value := range rows {
+			err := st.addRowFromMap(value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 }
 
@@ -73,6 +87,16 @@ func (st *SafeTable) AddRows(rows []map[string]string) []map[string]string {
 	return failure
 }
 
+// AddStructs used to add a struct, a pointer to struct, or a slice of them(see AddRow). Unlike AddRow, the rows after
+// a failed row are still added. It returns a slice of map which add failed, a failed struct is returned as the map of
+// its fields.
+// Error:
+// - If rows is not a struct, a pointer to struct, or a slice of them, an *exception.UnsupportedRowTypeError is
+// returned.
+func (st *SafeTable) AddStructs(rows interface{}) ([]map[string]string, error) {
+	return addStructs(rows, st.addRowFromMap)
+}
+
 func (st *SafeTable) addRowFromMap(row map[string]string) error {
 	for key := range row {
 		if !st.Columns.Exist(key) {
@@ -93,7 +117,12 @@ func (st *SafeTable) addRowFromMap(row map[string]string) error {
 		}
 	}
 
-	st.Row = append(st.Row, toSafeRow(row))
+	cells, err := toRow(row, st.Columns)
+	if err != nil {
+		return err
+	}
+	st.Row = append(st.Row, sync.Map{})
+	toSafeRow(cells, &st.Row[len(st.Row)-1])
 	return nil
 }
 
@@ -112,7 +141,46 @@ func (st *SafeTable) addRowFromSlice(row []string) error {
 		}
 	}
 
-	st.Row = append(st.Row, toSafeRow(rowMap))
+	cells, err := toRow(rowMap, st.Columns)
+	if err != nil {
+		return err
+	}
+	st.Row = append(st.Row, sync.Map{})
+	toSafeRow(cells, &st.Row[len(st.Row)-1])
+	return nil
+}
+
+// SetColumnType method used to declare the type of column, use the cell.Type* constants as kind. The existing values
+// of the column are converted to the new type.
+// Error:
+//   - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+//   - If kind is not one of the cell.Type* constants, an *exception.UnsupportedColumnTypeError is returned.
+//   - If an existing value can not be converted to kind, an *exception.ValueTypeError is returned and the table is not
+//     changed.
+func (st *SafeTable) SetColumnType(column string, kind int) error {
+	col := st.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	if kind < cell.TypeString || kind > cell.TypeTime {
+		return exception.UnsupportedColumnType(kind)
+	}
+
+	values := make([]cell.Cell, 0, len(st.Row))
+	for index := range st.Row {
+		value, _ := st.Row[index].Load(column)
+		original := value.(cell.Cell).Original()
+		c, err := cell.CreateTypedData(original, kind)
+		if err != nil {
+			return exception.ValueType(column, original, cell.TypeName(kind))
+		}
+		values = append(values, c)
+	}
+
+	col.SetType(kind)
+	for index := range st.Row {
+		st.Row[index].Store(column, values[index])
+	}
 	return nil
 }
 
@@ -128,87 +196,59 @@ func (st *SafeTable) Empty() bool {
 
 // String method used to implement fmt.Stringer.
 func (st *SafeTable) String() string {
-	var (
-		// Variable columnMaxLength original mode is map[string]int
-		columnMaxLength sync.Map
-
-		// Variable tag original mode is map[string]cell.Cell
-		tag sync.Map
-
-		// Variable taga original mode is []map[string]cell.Cell
-		taga = make([]*sync.Map, 0)
-	)
-
-	for _, col := range st.Columns.base {
-		columnMaxLength.Store(col.Original(), col.Length())
-		tag.Store(col.String(), cell.CreateData("-"))
-	}
+	rows := st.rows()
+	return st.render(rows, st.columnLengths(rows))
+}
 
+// rows method returns the cells of all rows in the order of the table columns.
+func (st *SafeTable) rows() [][]cell.Cell {
+	rows := make([][]cell.Cell, 0, len(st.Row))
 	for index := range st.Row {
-		for _, col := range st.Columns.base {
-			value, _ := st.Row[index].Load(col.Original())
-			maxLength := max(col.Length(), value.(cell.Cell).Length())
-
-			value, _ = columnMaxLength.Load(col.Original())
-			maxLength = max(maxLength, value.(int))
-			columnMaxLength.Store(col.Original(), maxLength)
-		}
+		rows = append(rows, st.cells(&st.Row[index]))
 	}
+	return rows
+}
 
-	content := ""
-	icon := " "
-	// Print first line.
-	taga = append(taga, &tag)
-	if st.border {
-		content += st.printGroup(taga, &columnMaxLength)
-		icon = "|"
+// cells method returns the cells of row in the order of the table columns.
+func (st *SafeTable) cells(row *sync.Map) []cell.Cell {
+	cells := make([]cell.Cell, 0, st.Columns.Len())
+	for _, column := range st.Columns.base {
+		value, ok := row.Load(column.Original())
+		if !ok {
+			value = cell.CreateData(column.Default())
+		}
+		cells = append(cells, value.(cell.Cell))
 	}
 
-	// Print table column.
-	for index, column := range st.Columns.base {
-		value, _ := columnMaxLength.Load(column.Original())
-		itemLen := value.(int)
-		if st.border {
-			itemLen += 2
-		}
-		s := ""
-		switch column.Align() {
-		case R:
-			s, _ = right(column, itemLen, " ")
-		case L:
-			s, _ = left(column, itemLen, " ")
-		default:
-			s, _ = center(column, itemLen, " ")
-		}
-		if index == 0 {
-			s = icon + s + icon
-		} else {
-			s = "" + s + icon
+	if len(st.colorRules) > 0 {
+		values := make(Row)
+		for index, column := range st.Columns.base {
+			values[column.Original()] = cells[index]
 		}
-
-		content += s
+		st.applyColorRules(values, cells)
 	}
+	return cells
+}
 
-	if st.border {
-		content += "\n"
+// rowMaps method returns a snapshot of the rows as maps of column and cell.
+func (st *SafeTable) rowMaps() []map[string]cell.Cell {
+	rows := make([]map[string]cell.Cell, 0, len(st.Row))
+	for index := range st.Row {
+		row := make(map[string]cell.Cell)
+		st.Row[index].Range(func(key, value interface{}) bool {
+			row[key.(string)] = value.(cell.Cell)
+			return true
+		})
+		rows = append(rows, row)
 	}
+	return rows
+}
 
-	tableValue := taga
-	if !st.Empty() {
-		for index := range st.Row {
-			// map[string]cell.Cell
-			var cellMap sync.Map
-			f := func(key, value interface{}) bool {
-				column := st.Columns.Get(key.(string))
-				cellMap.Store(column.String(), value.(cell.Cell))
-				return true
-			}
-			st.Row[index].Range(f)
-			tableValue = append(tableValue, &cellMap)
-		}
-		tableValue = append(tableValue, &tag)
+// setRows method replaces all rows of the table with rows.
+func (st *SafeTable) setRows(rows []map[string]cell.Cell) {
+	safeRows := make([]sync.Map, len(rows))
+	for index, row := range rows {
+		toSafeRow(row, &safeRows[index])
 	}
-
-	content += st.printGroup(tableValue, &columnMaxLength)
-	return st.end(content)
+	st.Row = safeRows
 }
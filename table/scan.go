@@ -0,0 +1,167 @@
+// Package table define all table types methods.
+// scan.go used to read the rows of table into structs.
+package table
+
+import (
+	"encoding"
+	"fmt"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// Scan method used to read all rows into dest, which is a pointer to a slice of structs or pointers to structs. The
+// fields are mapped to the columns by the gotable tag, the same as CreateByStruct, and fields without column are left
+// unchanged. Values are converted to strings, integers, floats, bools, time.Time and types implementing
+// encoding.TextUnmarshaler, null values are left as zero values. dest is not changed if an error is returned.
+// Error:
+// - If dest is not a pointer to a slice of structs, an *exception.UnsupportedRowTypeError is returned.
+// - If a value can not be converted to its field, or the field is behind a nil pointer to an unexported embedded
+//   struct which can not be allocated, an *exception.ScanFieldError is returned.
+func (tb *Table) Scan(dest interface{}) error {
+	slice := reflect.ValueOf(dest)
+	if slice.Kind() != reflect.Ptr || slice.IsNil() || slice.Elem().Kind() != reflect.Slice {
+		return exception.UnsupportedRowType(dest)
+	}
+
+	elemType := slice.Elem().Type().Elem()
+	structType := elemType
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return exception.UnsupportedRowType(dest)
+	}
+
+	fields := structFields(structType)
+	rows := reflect.MakeSlice(slice.Elem().Type(), 0, len(tb.Row))
+	for index, row := range tb.Row {
+		value := reflect.New(structType).Elem()
+		for _, f := range fields {
+			column := tb.Columns.Get(f.name)
+			if column == nil {
+				continue
+			}
+
+			c, ok := row[f.name]
+			if !ok {
+				c = cell.CreateData(column.Default())
+			}
+			if _, null := c.(*cell.Null); null {
+				continue
+			}
+
+			field, ok := allocField(value, f.index)
+			if !ok {
+				err := fmt.Errorf("can not set embedded pointer to unexported struct %s", f.name)
+				return exception.ScanField(index, f.name, structType.FieldByIndex(f.index).Name, c.Original(), err)
+			}
+			err := setField(field, c.Original())
+			if err != nil {
+				return exception.ScanField(index, f.name, structType.FieldByIndex(f.index).Name, c.Original(), err)
+			}
+		}
+
+		if elemType.Kind() == reflect.Ptr {
+			value = value.Addr()
+		}
+		rows = reflect.Append(rows, value)
+	}
+	slice.Elem().Set(rows)
+	return nil
+}
+
+// allocField function returns the field of value at index, the nil pointers to embedded structs on the way are
+// allocated. It reports false if the field can not be set, e.g. it is behind a nil pointer to an unexported embedded
+// struct, which can not be allocated.
+func allocField(value reflect.Value, index []int) (reflect.Value, bool) {
+	for i, position := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				if !value.CanSet() {
+					return reflect.Value{}, false
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(position)
+	}
+	return value, value.CanSet()
+}
+
+// setField function converts s to the type of field and sets it. An empty string sets nothing unless the field is a
+// string or an encoding.TextUnmarshaler, a pointer field is left nil.
+func setField(field reflect.Value, s string) error {
+	if field.Kind() == reflect.Ptr {
+		if s == "" && field.Type().Elem().Kind() != reflect.String {
+			return nil
+		}
+		value := reflect.New(field.Type().Elem())
+		err := setField(value.Elem(), s)
+		if err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	if field.Type() == timeType {
+		if s == "" {
+			return nil
+		}
+		t, err := cell.CreateTime(s)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t.Value()))
+		return nil
+	}
+
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	if field.Kind() == reflect.String {
+		field.SetString(s)
+		return nil
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(v)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(v)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
@@ -7,7 +7,14 @@ import (
 )
 
 type Set struct {
-	base []*cell.Column
+	base   []*cell.Column
+	groups []headerGroup
+}
+
+// headerGroup struct is a parent label printed over contiguous columns.
+type headerGroup struct {
+	label   string
+	columns []string
 }
 
 func CreateSetFromString(columns ...string) (*Set, error) {
@@ -50,6 +57,7 @@ func (set *Set) exist(element string) int {
 
 func (set *Set) Clear() {
 	set.base = make([]*cell.Column, 0)
+	set.groups = nil
 }
 
 func (set *Set) Add(element string) error {
@@ -69,9 +77,32 @@ func (set *Set) Remove(element string) error {
 	}
 
 	set.base = append(set.base[:position], set.base[position+1:]...)
+	groups := make([]headerGroup, 0, len(set.groups))
+	for _, group := range set.groups {
+		columns := make([]string, 0, len(group.columns))
+		for _, column := range group.columns {
+			if column != element {
+				columns = append(columns, column)
+			}
+		}
+		if len(columns) > 0 {
+			groups = append(groups, headerGroup{label: group.label, columns: columns})
+		}
+	}
+	set.groups = groups
 	return nil
 }
 
+// Copy returns a new set which contains copies of all columns.
+func (set *Set) Copy() *Set {
+	columns := &Set{base: make([]*cell.Column, 0, set.Len())}
+	for _, column := range set.base {
+		columns.base = append(columns.base, column.Copy())
+	}
+	columns.groups = append([]headerGroup(nil), set.groups...)
+	return columns
+}
+
 func (set *Set) Get(name string) *cell.Column {
 	for _, h := range set.base {
 		if h.Original() == name {
@@ -113,3 +144,85 @@ func (set *Set) Equal(other *Set) bool {
 		}
 	}
 }
+
+// AddGroup method used to print label over columns in an extra header line, e.g. "Latency" over "p50", "p95" and
+// "p99". The columns must be contiguous and in the order of the set.
+// Error:
+// - If a column does not exist, an *exception.ColumnDoNotExistError is returned.
+// - If the columns are not contiguous or are already in another group, an *exception.InvalidHeaderGroupError is
+// returned.
+func (set *Set) AddGroup(label string, columns ...string) error {
+	if len(columns) == 0 {
+		return exception.InvalidHeaderGroup(label)
+	}
+
+	start := set.exist(columns[0])
+	for offset, column := range columns {
+		position := set.exist(column)
+		if position == -1 {
+			return exception.ColumnDoNotExist(column)
+		}
+		if position != start+offset || set.group(column) != -1 {
+			return exception.InvalidHeaderGroup(label)
+		}
+	}
+
+	set.groups = append(set.groups, headerGroup{label: label, columns: append([]string(nil), columns...)})
+	return nil
+}
+
+// RemoveGroup method used to remove the header group which label is label.
+func (set *Set) RemoveGroup(label string) {
+	groups := make([]headerGroup, 0, len(set.groups))
+	for _, group := range set.groups {
+		if group.label != label {
+			groups = append(groups, group)
+		}
+	}
+	set.groups = groups
+}
+
+// group method returns the index of the header group that contains column, it returns -1 if column is not in a group.
+func (set *Set) group(column string) int {
+	for index, group := range set.groups {
+		for _, name := range group.columns {
+			if name == column {
+				return index
+			}
+		}
+	}
+	return -1
+}
+
+// headerSpan struct is a label printed over count columns from start, the label of a column that is not in a group
+// is empty.
+type headerSpan struct {
+	label string
+	start int
+	count int
+}
+
+// spans method returns the header spans of all columns in order. It returns nil if there is no header group.
+func (set *Set) spans() []headerSpan {
+	if len(set.groups) == 0 {
+		return nil
+	}
+
+	spans := make([]headerSpan, 0, set.Len())
+	previous := -1
+	for index, column := range set.base {
+		group := set.group(column.Original())
+		if group != -1 && group == previous {
+			spans[len(spans)-1].count++
+			continue
+		}
+
+		label := ""
+		if group != -1 {
+			label = set.groups[group].label
+		}
+		spans = append(spans, headerSpan{label: label, start: index, count: 1})
+		previous = group
+	}
+	return spans
+}
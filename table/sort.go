@@ -0,0 +1,236 @@
+// Package table define all table types methods.
+// sort.go used to sort table rows.
+package table
+
+import (
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sort modes
+const (
+	// SortByType compares typed values by their type(number, bool, time) and other values as strings.
+	SortByType = iota
+	// SortNatural compares strings with embedded numbers in human order, e.g. "item2" < "item10".
+	SortNatural
+	// SortNumeric compares values as float numbers, values which are not numbers are placed first.
+	SortNumeric
+)
+
+// SortKey struct describes how to order the rows by a column.
+// - Column: Column name.
+// - Descending: Order from the largest value to the smallest value.
+// - Mode: One of SortByType, SortNatural and SortNumeric. It is ignored if Compare is not nil.
+// - Compare: Custom comparator, it returns a negative number if a < b, zero if a == b and a positive number otherwise.
+type SortKey struct {
+	Column     string
+	Descending bool
+	Mode       int
+	Compare    func(a, b cell.Cell) int
+}
+
+// Asc function returns an ascending SortKey of column.
+func Asc(column string) SortKey {
+	return SortKey{Column: column}
+}
+
+// Desc function returns a descending SortKey of column.
+func Desc(column string) SortKey {
+	return SortKey{Column: column, Descending: true}
+}
+
+func (key SortKey) compare(a, b cell.Cell) int {
+	result := 0
+	switch {
+	case key.Compare != nil:
+		result = key.Compare(a, b)
+	case key.Mode == SortNatural:
+		result = naturalCompare(a.Original(), b.Original())
+	case key.Mode == SortNumeric:
+		result = numericCompare(a.Original(), b.Original())
+	default:
+		result = typeCompare(a, b)
+	}
+
+	if key.Descending {
+		return -result
+	}
+	return result
+}
+
+// checkSortKeys method returns an *exception.ColumnDoNotExistError if a key refers to a nonexistent column.
+func (b *base) checkSortKeys(keys []SortKey) error {
+	for _, key := range keys {
+		if !b.Columns.Exist(key.Column) {
+			return exception.ColumnDoNotExist(key.Column)
+		}
+	}
+	return nil
+}
+
+// SortBy method used to sort the rows in place by keys. The sort is stable, rows with equal keys keep their order.
+// Error:
+// - If a key refers to a nonexistent column, an *exception.ColumnDoNotExistError is returned.
+func (tb *Table) SortBy(keys ...SortKey) error {
+	err := tb.checkSortKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(tb.Row, func(i, j int) bool {
+		for _, key := range keys {
+			result := key.compare(tb.Row[i][key.Column], tb.Row[j][key.Column])
+			if result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	})
+	return nil
+}
+
+// SortBy method used to sort the rows in place by keys. The sort is stable, rows with equal keys keep their order.
+// Error:
+// - If a key refers to a nonexistent column, an *exception.ColumnDoNotExistError is returned.
+func (st *SafeTable) SortBy(keys ...SortKey) error {
+	err := st.checkSortKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	rows := st.rowMaps()
+	order := make([]int, len(rows))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		for _, key := range keys {
+			result := key.compare(rows[order[i]][key.Column], rows[order[j]][key.Column])
+			if result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	})
+
+	sorted := make([]map[string]cell.Cell, 0, len(rows))
+	for _, position := range order {
+		sorted = append(sorted, rows[position])
+	}
+	st.setRows(sorted)
+	return nil
+}
+
+func typeCompare(a, b cell.Cell) int {
+	x, y := a.Value(), b.Value()
+	if x == nil || y == nil {
+		return compareBool(x != nil, y != nil)
+	}
+
+	switch v := x.(type) {
+	case int64:
+		if w, ok := y.(int64); ok {
+			return compareInt(v, w)
+		}
+	case float64:
+		if w, ok := y.(float64); ok {
+			return compareFloat(v, w)
+		}
+	case bool:
+		if w, ok := y.(bool); ok {
+			return compareBool(v, w)
+		}
+	case time.Time:
+		if w, ok := y.(time.Time); ok {
+			return compareInt(v.UnixNano(), w.UnixNano())
+		}
+	}
+	return strings.Compare(a.Original(), b.Original())
+}
+
+func numericCompare(a, b string) int {
+	x, errX := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, errY := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errX != nil || errY != nil {
+		if errX != nil && errY != nil {
+			return strings.Compare(a, b)
+		}
+		return compareBool(errX == nil, errY == nil)
+	}
+	return compareFloat(x, y)
+}
+
+// naturalCompare function compares a and b chunk by chunk, digit chunks are compared by their numeric value.
+func naturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		chunkA, chunkB := leadingChunk(a), leadingChunk(b)
+		a, b = a[len(chunkA):], b[len(chunkB):]
+
+		if isDigit(chunkA[0]) && isDigit(chunkB[0]) {
+			trimmedA, trimmedB := strings.TrimLeft(chunkA, "0"), strings.TrimLeft(chunkB, "0")
+			if len(trimmedA) != len(trimmedB) {
+				return compareInt(int64(len(trimmedA)), int64(len(trimmedB)))
+			}
+			if result := strings.Compare(trimmedA, trimmedB); result != 0 {
+				return result
+			}
+			continue
+		}
+
+		if result := strings.Compare(chunkA, chunkB); result != 0 {
+			return result
+		}
+	}
+	return compareInt(int64(len(a)), int64(len(b)))
+}
+
+// leadingChunk function returns the leading run of digits or non-digits of s.
+func leadingChunk(s string) string {
+	digit := isDigit(s[0])
+	end := 1
+	for end < len(s) && isDigit(s[end]) == digit {
+		end++
+	}
+	return s[:end]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func compareFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareInt(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareBool(x, y bool) int {
+	switch {
+	case x == y:
+		return 0
+	case y:
+		return -1
+	default:
+		return 1
+	}
+}
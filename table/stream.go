@@ -0,0 +1,137 @@
+// Package table define all table types methods.
+// stream.go used to write the table into an io.Writer.
+package table
+
+import (
+	"bufio"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"io"
+)
+
+// WriteTo method writes the table into w through a buffer, it implements io.WriterTo. It returns the number of bytes
+// written and the first error encountered.
+func (tb *Table) WriteTo(w io.Writer) (int64, error) {
+	return tb.writeTo(w, tb.rows())
+}
+
+// WriteTo method writes the table into w through a buffer, it implements io.WriterTo. It returns the number of bytes
+// written and the first error encountered.
+func (st *SafeTable) WriteTo(w io.Writer) (int64, error) {
+	return st.writeTo(w, st.rows())
+}
+
+// Stream struct used to write rows into an io.Writer as soon as they are added to the table. The column widths are
+// fixed when the stream is created, a value longer than the width of its column is wrapped by
+// the wrap mode of the column(see SetColumnMaxWidth). The footer row is not written by a stream.
+type Stream struct {
+	table    *base
+	add      func(row interface{}) error
+	length   func() int
+	row      func(index int) []cell.Cell
+	buffer   *bufio.Writer
+	printer  *printer
+	lengths  []int
+	previous []cell.Cell
+	rows     int
+	started  bool
+	closed   bool
+}
+
+func (b *base) stream(w io.Writer, widths []int, add func(row interface{}) error, length func() int,
+	row func(index int) []cell.Cell) *Stream {
+	lengths := make([]int, 0, b.Columns.Len())
+	for index, column := range b.Columns.base {
+		length := column.Length()
+		if index < len(widths) {
+			length = max(length, widths[index])
+		}
+		lengths = append(lengths, length)
+	}
+	b.widenGroups(lengths)
+
+	buffer := bufio.NewWriter(w)
+	return &Stream{
+		table:   b,
+		add:     add,
+		length:  length,
+		row:     row,
+		buffer:  buffer,
+		printer: newPrinter(buffer),
+		lengths: lengths,
+	}
+}
+
+// Stream method returns a *Stream that writes into w. The widths argument gives the width of each column in the order
+// of the columns, the width of a column is not less than the length of its name.
+func (tb *Table) Stream(w io.Writer, widths ...int) *Stream {
+	row := func(index int) []cell.Cell {
+		return tb.cells(tb.Row[index])
+	}
+	return tb.stream(w, widths, tb.AddRow, tb.Length, row)
+}
+
+// Stream method returns a *Stream that writes into w. The widths argument gives the width of each column in the order
+// of the columns, the width of a column is not less than the length of its name.
+func (st *SafeTable) Stream(w io.Writer, widths ...int) *Stream {
+	row := func(index int) []cell.Cell {
+		return st.cells(&st.Row[index])
+	}
+	return st.stream(w, widths, st.AddRow, st.Length, row)
+}
+
+// AddRow method adds row to the table and writes it. The header of the table is written before the first row. It
+// supports the same argument types as the AddRow method of the table, every row added by the call is written, even if
+// an error occurs after some rows of a slice are added. Each row is written with its line break.
+// Error:
+// - If the stream is closed, an *exception.StreamClosedError is returned and the row is not added.
+func (s *Stream) AddRow(row interface{}) error {
+	if s.closed {
+		return exception.StreamClosed()
+	}
+
+	start := s.length()
+	err := s.add(row)
+	end := s.length()
+	if start == end {
+		return err
+	}
+
+	if !s.started {
+		s.table.printTitle(s.printer, s.table.title, s.lengths)
+		s.table.printHeader(s.printer, s.lengths)
+		s.started = true
+	}
+	for index := start; index < end; index++ {
+		cells := s.row(index)
+		s.table.printBody(s.printer, cells, s.previous, s.rows, s.lengths)
+		s.previous = cells
+		s.rows++
+	}
+	s.printer.breakLine()
+
+	flushErr := s.buffer.Flush()
+	if err != nil {
+		return err
+	}
+	return flushErr
+}
+
+// Close method writes the bottom border, the caption and the ending of the table. The header is written if no row was
+// added. Calling Close more than once does nothing.
+func (s *Stream) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if !s.started {
+		s.table.printTitle(s.printer, s.table.title, s.lengths)
+		s.table.printHeader(s.printer, s.lengths)
+	} else {
+		s.table.printBottom(s.printer, s.lengths)
+	}
+	s.table.printTitle(s.printer, s.table.caption, s.lengths)
+	s.printer.close(s.table.End)
+	return s.buffer.Flush()
+}
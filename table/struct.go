@@ -0,0 +1,283 @@
+// Package table define all table types methods.
+// struct.go used to create columns and rows from structs.
+package table
+
+import (
+	"fmt"
+	"github.com/liushuochen/gotable/cell"
+	"github.com/liushuochen/gotable/exception"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// structField struct describes a struct field mapped to a column by the gotable tag, e.g.
+// `gotable:"name,omitempty,default=N/A,align=right"`.
+// - name: Column name, the field name is used if the tag does not give a name.
+// - index: Index sequence of the field used by reflect.Value.FieldByIndex.
+// - omitempty: Omit the zero value, so that the default value of the column is used.
+// - defaultValue: Value used instead of the zero value.
+// - align: Alignment of the column, -1 means not set.
+type structField struct {
+	name         string
+	index        []int
+	omitempty    bool
+	defaultValue *string
+	align        int
+}
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// structFields function returns the fields of struct type t in order. Embedded structs are flattened, nested structs
+// are flattened with the name of the field as prefix, e.g. "address.city". Unexported fields and fields tagged with
+// "-" are ignored.
+func structFields(t reflect.Type) []structField {
+	return appendStructFields(nil, t, "", nil)
+}
+
+func appendStructFields(fields []structField, t reflect.Type, prefix string, index []int) []structField {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("gotable")
+		if tag == "-" {
+			continue
+		}
+		f := parseTag(tag)
+		f.index = append(append([]int(nil), index...), i)
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		flatten := fieldType.Kind() == reflect.Struct && !isScalarStruct(fieldType)
+		if flatten && field.Anonymous && f.name == "" {
+			fields = appendStructFields(fields, fieldType, prefix, f.index)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if f.name == "" {
+			f.name = field.Name
+		}
+		if flatten {
+			fields = appendStructFields(fields, fieldType, prefix+f.name+".", f.index)
+			continue
+		}
+		f.name = prefix + f.name
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// isScalarStruct function reports whether values of struct type t are printed as a single value.
+func isScalarStruct(t reflect.Type) bool {
+	return t == timeType || t.Implements(stringerType) || reflect.PtrTo(t).Implements(stringerType)
+}
+
+func parseTag(tag string) structField {
+	options := strings.Split(tag, ",")
+	f := structField{name: options[0], align: -1}
+	for _, option := range options[1:] {
+		switch {
+		case option == "omitempty":
+			f.omitempty = true
+		case strings.HasPrefix(option, "default="):
+			value := strings.TrimPrefix(option, "default=")
+			f.defaultValue = &value
+		case strings.HasPrefix(option, "align="):
+			switch strings.TrimPrefix(option, "align=") {
+			case "left":
+				f.align = L
+			case "right":
+				f.align = R
+			case "center":
+				f.align = C
+			}
+		}
+	}
+	return f
+}
+
+// CreateSetFromStruct function creates a set from the fields of v, which is a struct or a pointer to struct. The
+// column name, default value and alignment are read from the gotable tag.
+// Error:
+// - If v is not a struct or the struct has no column, an *exception.ColumnsLengthError is returned.
+// - If columns contain duplicate values, an error is returned.
+func CreateSetFromStruct(v interface{}) (*Set, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, exception.ColumnsLength()
+	}
+
+	fields := structFields(t)
+	if len(fields) == 0 {
+		return nil, exception.ColumnsLength()
+	}
+
+	set := &Set{base: make([]*cell.Column, 0, len(fields))}
+	for _, f := range fields {
+		err := set.Add(f.name)
+		if err != nil {
+			return nil, err
+		}
+
+		column := set.Get(f.name)
+		if f.defaultValue != nil {
+			column.SetDefault(*f.defaultValue)
+		}
+		if f.align != -1 {
+			column.SetAlign(f.align)
+		}
+	}
+	return set, nil
+}
+
+// structRows function converts v to rows if v is a struct, a pointer to struct, or a slice or an array of them. It
+// reports whether v is converted, a slice or an array is converted only if its element type is a struct or a pointer
+// to struct, even if it is empty.
+func structRows(v interface{}) ([]map[string]string, bool) {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return nil, false
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		elem := value.Type().Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		rows := make([]map[string]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			row, ok := structRow(value.Index(i))
+			if !ok {
+				return nil, false
+			}
+			rows = append(rows, row)
+		}
+		return rows, true
+	default:
+		row, ok := structRow(value)
+		if !ok {
+			return nil, false
+		}
+		return []map[string]string{row}, true
+	}
+}
+
+// structRow function converts a struct or a pointer to struct to a row, the keys are the column names of the fields.
+func structRow(value reflect.Value) (map[string]string, bool) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	row := make(map[string]string)
+	for _, f := range structFields(value.Type()) {
+		field, ok := fieldByIndex(value, f.index)
+		if !ok || field.IsZero() {
+			if f.defaultValue != nil {
+				row[f.name] = *f.defaultValue
+				continue
+			}
+			if f.omitempty {
+				continue
+			}
+		}
+
+		if !ok {
+			row[f.name] = ""
+		} else {
+			row[f.name] = formatValue(field)
+		}
+	}
+	return row, true
+}
+
+// fieldByIndex function returns the field of value at index, it reports false if a pointer on the way is nil.
+func fieldByIndex(value reflect.Value, index []int) (reflect.Value, bool) {
+	for i, position := range index {
+		if i > 0 {
+			if value.Kind() == reflect.Ptr {
+				if value.IsNil() {
+					return reflect.Value{}, false
+				}
+				value = value.Elem()
+			}
+		}
+		value = value.Field(position)
+	}
+	return value, true
+}
+
+// formatValue function returns the string of a field value. A fmt.Stringer is formatted by its String method and a
+// time.Time is formatted in RFC 3339 format.
+func formatValue(value reflect.Value) string {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		if value.CanInterface() {
+			if stringer, ok := value.Interface().(fmt.Stringer); ok {
+				return stringer.String()
+			}
+		}
+		value = value.Elem()
+	}
+
+	if !value.CanInterface() {
+		return fmt.Sprint(value)
+	}
+	v := value.Interface()
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	if stringer, ok := v.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	if value.CanAddr() {
+		if stringer, ok := value.Addr().Interface().(fmt.Stringer); ok {
+			return stringer.String()
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// addStructs function adds rows by add, rows is a value supported by structRows. It returns the rows which add
+// failed, or an *exception.UnsupportedRowTypeError if rows is not supported.
+func addStructs(rows interface{}, add func(row map[string]string) error) ([]map[string]string, error) {
+	values, ok := structRows(rows)
+	if !ok {
+		return nil, exception.UnsupportedRowType(rows)
+	}
+
+	failure := make([]map[string]string, 0)
+	for _, row := range values {
+		err := add(row)
+		if err != nil {
+			failure = append(failure, row)
+		}
+	}
+	return failure, nil
+}
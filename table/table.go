@@ -16,6 +16,23 @@ const (
 	L       = cell.AlignLeft
 	R       = cell.AlignRight
 	Default = "__DEFAULT__"
+	Null    = cell.NullValue
+)
+
+// Wrap modes
+const (
+	WrapWord     = cell.WrapWord
+	WrapHard     = cell.WrapHard
+	WrapTruncate = cell.WrapTruncate
+)
+
+// Column types
+const (
+	TypeString = cell.TypeString
+	TypeInt    = cell.TypeInt
+	TypeFloat  = cell.TypeFloat
+	TypeBool   = cell.TypeBool
+	TypeTime   = cell.TypeTime
 )
 
 // Table struct:
@@ -63,6 +80,10 @@ func (tb *Table) AddColumn(column string) error {
 //   exist, the AddRow method returns an error.
 // For Slice argument, you must ensure that the slice length is equal to the column length. Method will automatically
 //   map values in Slice and columns. The default value cannot be omitted and must use gotable.Default constant.
+// For Struct argument(a struct, a pointer to struct, or a slice of them), each field is mapped to the column named by
+//   the gotable tag, e.g. `gotable:"name,omitempty,default=N/A,align=right"`. A fmt.Stringer is added by its String
+//   method, embedded and nested structs are flattened(see CreateSetFromStruct). The rows of a slice are added in
+//   order until an error occurs.
 // Return error types:
 //   - *exception.UnsupportedRowTypeError: It returned when the type of the argument is not supported.
 //   - *exception.RowLengthNotEqualColumnsError: It returned if the argument is type of the Slice but the length is
@@ -76,7 +97,17 @@ func (tb *Table) AddRow(row interface{}) error {
 	case map[string]string:
 		return tb.addRowFromMap(v)
 	default:
-		return exception.UnsupportedRowType(v)
+		rows, ok := structRows(v)
+		if !ok {
+			return exception.UnsupportedRowType(v)
+		}
+		for _, value := range rows {
+			err := tb.addRowFromMap(value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 }
 
@@ -95,7 +126,11 @@ func (tb *Table) addRowFromSlice(row []string) error {
 		}
 	}
 
-	tb.Row = append(tb.Row, toRow(rowMap))
+	cells, err := toRow(rowMap, tb.Columns)
+	if err != nil {
+		return err
+	}
+	tb.Row = append(tb.Row, cells)
 	return nil
 }
 
@@ -119,7 +154,11 @@ func (tb *Table) addRowFromMap(row map[string]string) error {
 		}
 	}
 
-	tb.Row = append(tb.Row, toRow(row))
+	cells, err := toRow(row, tb.Columns)
+	if err != nil {
+		return err
+	}
+	tb.Row = append(tb.Row, cells)
 	return nil
 }
 
@@ -135,76 +174,43 @@ func (tb *Table) AddRows(rows []map[string]string) []map[string]string {
 	return failure
 }
 
+// AddStructs used to add a struct, a pointer to struct, or a slice of them(see AddRow). Unlike AddRow, the rows after
+// a failed row are still added. It returns a slice of map which add failed, a failed struct is returned as the map of
+// its fields.
+// Error:
+// - If rows is not a struct, a pointer to struct, or a slice of them, an *exception.UnsupportedRowTypeError is
+// returned.
+func (tb *Table) AddStructs(rows interface{}) ([]map[string]string, error) {
+	return addStructs(rows, tb.addRowFromMap)
+}
+
 // String method used to implement fmt.Stringer.
 func (tb *Table) String() string {
-	columnMaxLength := make(map[string]int)
-	tag := make(map[string]cell.Cell)
-	taga := make([]map[string]cell.Cell, 0)
-	for _, h := range tb.Columns.base {
-		columnMaxLength[h.Original()] = h.Length()
-		tag[h.String()] = cell.CreateData("-")
-	}
-
-	for _, data := range tb.Row {
-		for _, h := range tb.Columns.base {
-			maxLength := max(h.Length(), data[h.Original()].Length())
-			maxLength = max(maxLength, columnMaxLength[h.Original()])
-			columnMaxLength[h.Original()] = maxLength
-		}
-	}
-
-	content := ""
-	icon := " "
-	// Print first line.
-	taga = append(taga, tag)
-	if tb.border {
-		content += tb.printGroup(taga, columnMaxLength)
-		icon = "|"
-	}
-
-	// Print table column.
-	for index, column := range tb.Columns.base {
-		itemLen := columnMaxLength[column.Original()]
-		if tb.border {
-			itemLen += 2
-		}
-		s := ""
-		switch column.Align() {
-		case R:
-			s, _ = right(column, itemLen, " ")
-		case L:
-			s, _ = left(column, itemLen, " ")
-		default:
-			s, _ = center(column, itemLen, " ")
-		}
-		if index == 0 {
-			s = icon + s + icon
-		} else {
-			s = "" + s + icon
-		}
-
-		content += s
-	}
+	rows := tb.rows()
+	return tb.render(rows, tb.columnLengths(rows))
+}
 
-	if tb.border {
-		content += "\n"
+// rows method returns the cells of all rows in the order of the table columns.
+func (tb *Table) rows() [][]cell.Cell {
+	rows := make([][]cell.Cell, 0, len(tb.Row))
+	for _, row := range tb.Row {
+		rows = append(rows, tb.cells(row))
 	}
+	return rows
+}
 
-	tableValue := taga
-	if !tb.Empty() {
-		for _, row := range tb.Row {
-			value := make(map[string]cell.Cell)
-			for key := range row {
-				col := tb.Columns.Get(key)
-				value[col.String()] = row[key]
-			}
-			tableValue = append(tableValue, value)
+// cells method returns the cells of row in the order of the table columns.
+func (tb *Table) cells(row map[string]cell.Cell) []cell.Cell {
+	cells := make([]cell.Cell, 0, tb.Columns.Len())
+	for _, column := range tb.Columns.base {
+		c, ok := row[column.Original()]
+		if !ok {
+			c = cell.CreateData(column.Default())
 		}
-		tableValue = append(tableValue, tag)
+		cells = append(cells, c)
 	}
-
-	content += tb.printGroup(tableValue, columnMaxLength)
-	return tb.end(content)
+	tb.applyColorRules(row, cells)
+	return cells
 }
 
 // Empty method is used to determine whether the table is empty.
@@ -222,7 +228,7 @@ func (tb *Table) GetValues() []map[string]string {
 	for _, value := range tb.Row {
 		ms := make(map[string]string)
 		for k, v := range value {
-			ms[k] = v.String()
+			ms[k] = v.Original()
 		}
 		values = append(values, ms)
 	}
@@ -234,7 +240,7 @@ func (tb *Table) Exist(value map[string]string) bool {
 		exist := true
 		for key := range value {
 			v, ok := row[key]
-			if !ok || v.String() != value[key] {
+			if !ok || v.Original() != value[key] {
 				exist = false
 				break
 			}
@@ -247,11 +253,20 @@ func (tb *Table) Exist(value map[string]string) bool {
 }
 
 func (tb *Table) json(indent int) ([]byte, error) {
-	data := make([]map[string]string, 0)
+	data := make([]map[string]interface{}, 0)
 	for _, row := range tb.Row {
-		element := make(map[string]string)
+		element := make(map[string]interface{})
 		for col, value := range row {
-			element[col] = value.String()
+			element[col] = value.Value()
+		}
+		data = append(data, element)
+	}
+	if footer := tb.exportedFooter(); footer != nil {
+		element := make(map[string]interface{})
+		for index, column := range tb.Columns.base {
+			if _, ok := tb.footer[column.Original()]; ok {
+				element[column.Original()] = footer[index].Value()
+			}
 		}
 		data = append(data, element)
 	}
@@ -267,8 +282,19 @@ func (tb *Table) json(indent int) ([]byte, error) {
 	return json.MarshalIndent(data, "", strings.Join(elems, " "))
 }
 
+// exportedFooter method returns the footer row written into the JSON and CSV exports, it returns nil if the footer
+// is not exported(see ExportFooter).
+func (tb *Table) exportedFooter() []cell.Cell {
+	if !tb.exportFooter {
+		return nil
+	}
+	return tb.footerRow(tb.rows())
+}
+
 // The JSON method returns the JSON string corresponding to the gotable. The indent argument represents the indent
 // value. If index is less than zero, the JSON method treats it as zero.
+// Values of typed columns are written as JSON numbers, booleans and strings in RFC 3339 format(time), null values are
+// written as null. The footer row is written as the last object if it is exported(see ExportFooter).
 func (tb *Table) JSON(indent int) (string, error) {
 	bytes, err := tb.json(indent)
 	if err != nil {
@@ -293,7 +319,7 @@ func (tb *Table) XML(indent int) string {
 	for _, row := range tb.Row {
 		contents = append(contents, indentString+"<row>")
 		for name := range row {
-			line := indentString + indentString + fmt.Sprintf("<%s>%s</%s>", name, row[name], name)
+			line := indentString + indentString + fmt.Sprintf("<%s>%s</%s>", name, row[name].Original(), name)
 			contents = append(contents, line)
 		}
 		contents = append(contents, indentString+"</row>")
@@ -303,12 +329,36 @@ func (tb *Table) XML(indent int) string {
 	return content
 }
 
-func (tb *Table) CloseBorder() {
-	tb.border = false
-}
+// SetColumnType method used to declare the type of column, use the cell.Type* constants as kind. The existing values
+// of the column are converted to the new type.
+// Error:
+//   - If column does not exist, an *exception.ColumnDoNotExistError is returned.
+//   - If kind is not one of the cell.Type* constants, an *exception.UnsupportedColumnTypeError is returned.
+//   - If an existing value can not be converted to kind, an *exception.ValueTypeError is returned and the table is not
+//     changed.
+func (tb *Table) SetColumnType(column string, kind int) error {
+	col := tb.Columns.Get(column)
+	if col == nil {
+		return exception.ColumnDoNotExist(column)
+	}
+	if kind < cell.TypeString || kind > cell.TypeTime {
+		return exception.UnsupportedColumnType(kind)
+	}
 
-func (tb *Table) OpenBorder() {
-	tb.border = true
+	values := make([]cell.Cell, 0, len(tb.Row))
+	for _, row := range tb.Row {
+		value, err := cell.CreateTypedData(row[column].Original(), kind)
+		if err != nil {
+			return exception.ValueType(column, row[column].Original(), cell.TypeName(kind))
+		}
+		values = append(values, value)
+	}
+
+	col.SetType(kind)
+	for index, row := range tb.Row {
+		row[column] = values[index]
+	}
+	return nil
 }
 
 func (tb *Table) Align(column string, mode int) {
@@ -368,6 +418,13 @@ func (tb *Table) ToCSVFile(path string) error {
 		}
 		contents = append(contents, content)
 	}
+	if footer := tb.exportedFooter(); footer != nil {
+		content := make([]string, 0, len(footer))
+		for _, c := range footer {
+			content = append(content, c.Original())
+		}
+		contents = append(contents, content)
+	}
 
 	err = writer.WriteAll(contents)
 	if err != nil {
@@ -394,16 +451,6 @@ func (tb *Table) EqualColumns(other *Table) bool {
 	return tb.Columns.Equal(other.Columns)
 }
 
-func (tb *Table) SetColumnColor(columnName string, display, fount, background int) {
-	background += 10
-	for _, col := range tb.Columns.base {
-		if col.Original() == columnName {
-			col.SetColor(display, fount, background)
-			break
-		}
-	}
-}
-
 // GoString method used to implement fmt.GoStringer.
 func (tb *Table) GoString() string {
 	resultList := tb.header()
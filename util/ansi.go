@@ -0,0 +1,58 @@
+package util
+
+import "strings"
+
+const (
+	escape = 0x1B
+	bell   = 0x07
+)
+
+// StripANSI removes the terminal escape sequences from s, e.g. SGR color sequences("\033[31m") and OSC 8
+// hyperlinks("\033]8;;https://example.com\033\\").
+func StripANSI(s string) string {
+	if strings.IndexByte(s, escape) == -1 {
+		return s
+	}
+
+	var builder strings.Builder
+	for index := 0; index < len(s); {
+		if s[index] != escape {
+			builder.WriteByte(s[index])
+			index++
+			continue
+		}
+		index = escapeEnd(s, index)
+	}
+	return builder.String()
+}
+
+// escapeEnd returns the end position of the escape sequence which starts at start.
+func escapeEnd(s string, start int) int {
+	if start+1 >= len(s) {
+		return len(s)
+	}
+
+	end := start + 2
+	switch s[start+1] {
+	case '[':
+		// CSI sequence ends with a byte in range 0x40–0x7E.
+		for end < len(s) && (s[end] < 0x40 || s[end] > 0x7E) {
+			end++
+		}
+		if end < len(s) {
+			end++
+		}
+	case ']':
+		// OSC sequence ends with BEL or ST("\033\\").
+		for end < len(s) {
+			if s[end] == bell {
+				return end + 1
+			}
+			if s[end] == escape && end+1 < len(s) && s[end+1] == '\\' {
+				return end + 2
+			}
+			end++
+		}
+	}
+	return end
+}
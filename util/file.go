@@ -26,3 +26,11 @@ func IsJsonFile(path string) bool {
 func IsCSVFile(path string) bool {
 	return isFormatFile(path, "csv")
 }
+
+func IsMarkdownFile(path string) bool {
+	return isFormatFile(path, "md")
+}
+
+func IsHTMLFile(path string) bool {
+	return isFormatFile(path, "html") || isFormatFile(path, "htm")
+}
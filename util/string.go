@@ -2,11 +2,6 @@ package util
 
 import (
 	"strings"
-	"unicode"
-)
-
-const (
-	chineseSymbol = "！……（），。？、"
 )
 
 func Capitalize(s string) string {
@@ -21,27 +16,24 @@ func Capitalize(s string) string {
 	return strings.ToUpper(string(s[0])) + s[1:]
 }
 
+// Length returns the display width of s in a terminal. Wide characters(e.g. Chinese, Japanese, Korean, emoji and
+// fullwidth forms) occupy two cells, combining marks, joiners and variation selectors occupy no cells. Terminal escape
+// sequences(colors and hyperlinks) are not counted. If s contains multiple lines, the width of the widest line is
+// returned.
 func Length(s string) int {
-	length := 0
-	for _, c := range s {
-		if isChinese(c) {
-			length += 2
-		} else {
-			length += 1
+	if strings.ContainsAny(s, "\r\n") {
+		length := 0
+		for _, line := range Lines(s) {
+			if l := Length(line); l > length {
+				length = l
+			}
 		}
-	}
-	return length
-}
-
-func isChinese(c int32) bool {
-	if unicode.Is(unicode.Han, c) {
-		return true
+		return length
 	}
 
-	for _, s := range chineseSymbol {
-		if c == s {
-			return true
-		}
+	length := 0
+	for _, grapheme := range Graphemes(StripANSI(s)) {
+		length += GraphemeWidth(grapheme)
 	}
-	return false
+	return length
 }
@@ -0,0 +1,30 @@
+package util
+
+import (
+	"os"
+	"strconv"
+)
+
+// IsTerminal reports whether file is a terminal(character device), e.g. it returns false if os.Stdout is redirected to
+// a file or a pipe.
+func IsTerminal(file *os.File) bool {
+	stat, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
+
+// TerminalWidth returns the number of columns of the terminal. The size of the terminal of os.Stdout is used on Linux,
+// then the COLUMNS environment variable. It returns 0 if the width is unknown.
+func TerminalWidth() int {
+	if width, ok := terminalWidth(os.Stdout.Fd()); ok {
+		return width
+	}
+
+	width, err := strconv.Atoi(os.Getenv("COLUMNS"))
+	if err != nil || width < 0 {
+		return 0
+	}
+	return width
+}
@@ -0,0 +1,26 @@
+//go:build linux
+// +build linux
+
+package util
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+type winsize struct {
+	rows    uint16
+	columns uint16
+	xPixel  uint16
+	yPixel  uint16
+}
+
+// terminalWidth returns the columns of the terminal of fd by TIOCGWINSZ ioctl.
+func terminalWidth(fd uintptr) (int, bool) {
+	ws := new(winsize)
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(ws)))
+	if errno != 0 || ws.columns == 0 {
+		return 0, false
+	}
+	return int(ws.columns), true
+}
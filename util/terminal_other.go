@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package util
+
+// terminalWidth is not supported on this platform, the COLUMNS environment variable is used instead.
+func terminalWidth(fd uintptr) (int, bool) {
+	return 0, false
+}
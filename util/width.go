@@ -0,0 +1,200 @@
+package util
+
+import "unicode"
+
+const (
+	zeroWidthJoiner  = 0x200D
+	variationText    = 0xFE0E
+	variationEmoji   = 0xFE0F
+	regionalStart    = 0x1F1E6
+	regionalEnd      = 0x1F1FF
+	skinToneStart    = 0x1F3FB
+	skinToneEnd      = 0x1F3FF
+	hangulJungseong  = 0x1160
+	hangulJongseongE = 0x11FF
+)
+
+// wideTable contains the ranges of East Asian Wide(W) and Fullwidth(F) characters, emoji with default emoji
+// presentation are included.
+var wideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115F, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232A, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F0, Stride: 1},
+		{Lo: 0x23F3, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x267F, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26CE, Stride: 1},
+		{Lo: 0x26D4, Hi: 0x26D4, Stride: 1},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26F5, Stride: 1},
+		{Lo: 0x26FA, Hi: 0x26FA, Stride: 1},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274C, Stride: 1},
+		{Lo: 0x274E, Hi: 0x274E, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27B0, Stride: 1},
+		{Lo: 0x27BF, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x2E80, Hi: 0x303E, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33FF, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+		{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1},
+		{Lo: 0xA000, Hi: 0xA4CF, Stride: 1},
+		{Lo: 0xA960, Hi: 0xA97F, Stride: 1},
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1},
+		{Lo: 0xFE10, Hi: 0xFE19, Stride: 1},
+		{Lo: 0xFE30, Hi: 0xFE6F, Stride: 1},
+		{Lo: 0xFF01, Hi: 0xFF60, Stride: 1},
+		{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16FE0, Hi: 0x16FE4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18CFF, Stride: 1},
+		{Lo: 0x1B000, Hi: 0x1B2FF, Stride: 1},
+		{Lo: 0x1F004, Hi: 0x1F004, Stride: 1},
+		{Lo: 0x1F0CF, Hi: 0x1F0CF, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F251, Stride: 1},
+		{Lo: 0x1F260, Hi: 0x1F265, Stride: 1},
+		{Lo: 0x1F300, Hi: 0x1F320, Stride: 1},
+		{Lo: 0x1F32D, Hi: 0x1F335, Stride: 1},
+		{Lo: 0x1F337, Hi: 0x1F37C, Stride: 1},
+		{Lo: 0x1F37E, Hi: 0x1F393, Stride: 1},
+		{Lo: 0x1F3A0, Hi: 0x1F3CA, Stride: 1},
+		{Lo: 0x1F3CF, Hi: 0x1F3D3, Stride: 1},
+		{Lo: 0x1F3E0, Hi: 0x1F3F0, Stride: 1},
+		{Lo: 0x1F3F4, Hi: 0x1F3F4, Stride: 1},
+		{Lo: 0x1F3F8, Hi: 0x1F43E, Stride: 1},
+		{Lo: 0x1F440, Hi: 0x1F440, Stride: 1},
+		{Lo: 0x1F442, Hi: 0x1F4FC, Stride: 1},
+		{Lo: 0x1F4FF, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F54B, Hi: 0x1F54E, Stride: 1},
+		{Lo: 0x1F550, Hi: 0x1F567, Stride: 1},
+		{Lo: 0x1F57A, Hi: 0x1F57A, Stride: 1},
+		{Lo: 0x1F595, Hi: 0x1F596, Stride: 1},
+		{Lo: 0x1F5A4, Hi: 0x1F5A4, Stride: 1},
+		{Lo: 0x1F5FB, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6C5, Stride: 1},
+		{Lo: 0x1F6CC, Hi: 0x1F6CC, Stride: 1},
+		{Lo: 0x1F6D0, Hi: 0x1F6D2, Stride: 1},
+		{Lo: 0x1F6D5, Hi: 0x1F6D7, Stride: 1},
+		{Lo: 0x1F6DC, Hi: 0x1F6DF, Stride: 1},
+		{Lo: 0x1F6EB, Hi: 0x1F6EC, Stride: 1},
+		{Lo: 0x1F6F4, Hi: 0x1F6FC, Stride: 1},
+		{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+		{Lo: 0x1F7F0, Hi: 0x1F7F0, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1F9FF, Stride: 1},
+		{Lo: 0x1FA70, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2FFFD, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3FFFD, Stride: 1},
+	},
+}
+
+// RuneWidth returns the number of terminal cells occupied by c when it is printed alone. Control characters,
+// combining marks and format characters occupy zero cells, wide and fullwidth characters occupy two cells. Ambiguous
+// characters(e.g. "…") occupy one cell.
+func RuneWidth(c rune) int {
+	switch {
+	case c == 0 || c < 0x20 || (c >= 0x7F && c < 0xA0):
+		return 0
+	case c < 0x300:
+		return 1
+	case isZeroWidth(c):
+		return 0
+	case unicode.Is(wideTable, c) || unicode.Is(unicode.Han, c) || isRegionalIndicator(c):
+		return 2
+	default:
+		return 1
+	}
+}
+
+func isZeroWidth(c rune) bool {
+	return unicode.In(c, unicode.Mn, unicode.Me, unicode.Cf) ||
+		(c >= hangulJungseong && c <= hangulJongseongE) ||
+		(c >= variationText && c <= variationEmoji) ||
+		(c >= 0xE0100 && c <= 0xE01EF) ||
+		(c >= skinToneStart && c <= skinToneEnd)
+}
+
+func isRegionalIndicator(c rune) bool {
+	return c >= regionalStart && c <= regionalEnd
+}
+
+// Graphemes splits s into user-perceived characters. A character is a base rune followed by combining marks,
+// variation selectors, emoji modifiers and runes joined by the zero width joiner. Two regional indicators form a flag.
+func Graphemes(s string) []string {
+	clusters := make([]string, 0, len(s))
+	runes := []rune(s)
+	for start := 0; start < len(runes); {
+		end := graphemeEnd(runes, start)
+		clusters = append(clusters, string(runes[start:end]))
+		start = end
+	}
+	return clusters
+}
+
+// graphemeEnd returns the end position of the user-perceived character which starts at start.
+func graphemeEnd(runes []rune, start int) int {
+	end := start + 1
+	if isRegionalIndicator(runes[start]) && end < len(runes) && isRegionalIndicator(runes[end]) {
+		end++
+	}
+
+	for end < len(runes) {
+		switch c := runes[end]; {
+		case c == zeroWidthJoiner && end+1 < len(runes):
+			end += 2
+		case isZeroWidth(c):
+			end++
+		default:
+			return end
+		}
+	}
+	return end
+}
+
+// GraphemeWidth returns the number of terminal cells occupied by a user-perceived character returned by Graphemes.
+// The width of an emoji sequence is two, and the emoji presentation selector makes a narrow character wide.
+func GraphemeWidth(grapheme string) int {
+	width := 0
+	joined := false
+	for index, c := range grapheme {
+		if index == 0 {
+			width = RuneWidth(c)
+			continue
+		}
+
+		switch {
+		case c == variationEmoji && width == 1:
+			width = 2
+		case c == zeroWidthJoiner:
+			joined = true
+		case joined && RuneWidth(c) > width:
+			width = RuneWidth(c)
+			joined = false
+		}
+	}
+	return width
+}
@@ -0,0 +1,84 @@
+package util
+
+import "strings"
+
+// Ellipsis is appended to a truncated string.
+const Ellipsis = "…"
+
+// Lines splits s into lines by "\n", "\r\n" and "\r".
+func Lines(s string) []string {
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	s = strings.Replace(s, "\r", "\n", -1)
+	return strings.Split(s, "\n")
+}
+
+// HardWrap splits a line into pieces whose display width is not greater than width. A wide character is never split.
+func HardWrap(line string, width int) []string {
+	if width <= 0 || Length(line) <= width {
+		return []string{line}
+	}
+
+	pieces := make([]string, 0)
+	var builder strings.Builder
+	length := 0
+	for _, grapheme := range Graphemes(StripANSI(line)) {
+		w := GraphemeWidth(grapheme)
+		if length+w > width && length > 0 {
+			pieces = append(pieces, builder.String())
+			builder.Reset()
+			length = 0
+		}
+		builder.WriteString(grapheme)
+		length += w
+	}
+	return append(pieces, builder.String())
+}
+
+// WordWrap splits a line into pieces whose display width is not greater than width at spaces. A word longer than
+// width is split by HardWrap.
+func WordWrap(line string, width int) []string {
+	if width <= 0 || Length(line) <= width {
+		return []string{line}
+	}
+
+	pieces := make([]string, 0)
+	current := ""
+	for _, word := range strings.Fields(StripANSI(line)) {
+		switch {
+		case current == "":
+			current = word
+		case Length(current)+1+Length(word) <= width:
+			current += " " + word
+			continue
+		default:
+			pieces = append(pieces, current)
+			current = word
+		}
+
+		if Length(current) > width {
+			wrapped := HardWrap(current, width)
+			pieces = append(pieces, wrapped[:len(wrapped)-1]...)
+			current = wrapped[len(wrapped)-1]
+		}
+	}
+	return append(pieces, current)
+}
+
+// Truncate cuts a line to the display width of width, the Ellipsis is appended if the line is cut.
+func Truncate(line string, width int) string {
+	if width <= 0 || Length(line) <= width {
+		return line
+	}
+
+	var builder strings.Builder
+	length := 0
+	for _, grapheme := range Graphemes(StripANSI(line)) {
+		w := GraphemeWidth(grapheme)
+		if length+w > width-Length(Ellipsis) {
+			break
+		}
+		builder.WriteString(grapheme)
+		length += w
+	}
+	return builder.String() + Ellipsis
+}